@@ -0,0 +1,50 @@
+(function () {
+  "use strict";
+
+  function cellValue(row, index, type) {
+    var text = row.cells[index].textContent.trim();
+    if (type === "number") {
+      var value = parseFloat(text);
+      return isNaN(value) ? -Infinity : value;
+    }
+    return text.toLowerCase();
+  }
+
+  document.querySelectorAll("table").forEach(function (table) {
+    var headers = table.querySelectorAll("th.sortable");
+    headers.forEach(function (th, index) {
+      th.addEventListener("click", function () {
+        var order = th.getAttribute("data-order") === "asc" ? "desc" : "asc";
+        var type = th.getAttribute("data-type");
+        var body = table.tBodies[0];
+        var rows = Array.prototype.slice.call(body.rows);
+
+        headers.forEach(function (other) {
+          other.removeAttribute("data-order");
+        });
+        th.setAttribute("data-order", order);
+
+        rows.sort(function (a, b) {
+          var x = cellValue(a, index, type);
+          var y = cellValue(b, index, type);
+          var result = x < y ? -1 : x > y ? 1 : 0;
+          return order === "asc" ? result : -result;
+        });
+        rows.forEach(function (row) {
+          body.appendChild(row);
+        });
+      });
+    });
+  });
+
+  document.querySelectorAll("input[data-filter]").forEach(function (input) {
+    var table = document.getElementById(input.getAttribute("data-filter"));
+    input.addEventListener("input", function () {
+      var query = input.value.trim().toLowerCase();
+      Array.prototype.forEach.call(table.tBodies[0].rows, function (row) {
+        var match = row.textContent.toLowerCase().indexOf(query) !== -1;
+        row.style.display = match ? "" : "none";
+      });
+    });
+  });
+})();
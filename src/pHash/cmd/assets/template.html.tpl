@@ -4,7 +4,7 @@
     <meta charset="UTF-8">
     <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
     <title>pHash Report</title>
-    <link rel="stylesheet" type="text/css" href="assets/bootstrap.min.css">
+    <style>{{ .Assets.CSS }}</style>
     <style>
       body {
         font-size: 12px;
@@ -13,6 +13,41 @@
       .bg-phash {
         background-color: #c8c8c8;
       }
+
+      th.sortable {
+        cursor: pointer;
+        white-space: nowrap;
+      }
+
+      th.sortable[data-order="asc"]::after {
+        content: " \25B2";
+      }
+
+      th.sortable[data-order="desc"]::after {
+        content: " \25BC";
+      }
+
+      .bar {
+        background-color: #2b4f99;
+        height: 14px;
+      }
+
+      .histogram {
+        align-items: flex-end;
+        display: flex;
+        height: 120px;
+      }
+
+      .histogram .bin {
+        background-color: #53d3d0;
+        flex: 1;
+        margin: 0 1px;
+        min-height: 1px;
+      }
+
+      .histogram .bin.pass {
+        background-color: #dc2d2e;
+      }
     </style>
   </head>
   <body>
@@ -22,42 +57,120 @@
           <div class="col-md-10 offset-md-1">
             <div class="jumbotron bg-white">
               <div class="col-md-8 offset-md-1">
-                <img src="assets/pHash_logo.svg" width="45%" alt="pHash">
+                <img src="{{ .Assets.Logo }}" width="45%" alt="pHash">
               </div>
+              <div class="col-xs-12" style="height:30px;"></div>
+              <div class="row">
+                <div class="col-md-6">
+                  <h5>Run</h5>
+                  <table class="table table-sm">
+                    <tbody>
+                      <tr><th scope="row">pHash</th><td>{{ .Run.Version }}</td></tr>
+                      <tr><th scope="row">Generated</th><td>{{ .Generated }}</td></tr>
+                      <tr><th scope="row">Input</th><td>{{ .Input }}</td></tr>
+                      <tr><th scope="row">Threshold</th><td>{{ .Run.Threshold }}</td></tr>
+                      <tr><th scope="row">Window / step</th><td>{{ .Run.Window }} / {{ .Run.Step }}</td></tr>{{ if .Run.Chromosomes }}
+                      <tr><th scope="row">Chromosome chunks / margin</th><td>{{ .Run.Chromosomes }} / {{ .Run.Margin }}</td></tr>{{ end }}{{ if .Run.BaselineCoverage }}
+                      <tr><th scope="row">Baseline coverage</th><td>{{ .Run.BaselineCoverage }}</td></tr>{{ end }}
+                      <tr><th scope="row">Contigs</th><td>{{ .Contigs }} ({{ len .Rows }} identified)</td></tr>{{ range .Skipped }}
+                      <tr><th scope="row">Skipped</th><td>{{ .Contigs }} {{ .Reason }} ({{ .Bases }} bases)</td></tr>{{ end }}
+                    </tbody>
+                  </table>
+                </div>
+                <div class="col-md-6">
+                  <h5>Database</h5>
+                  <table class="table table-sm">
+                    <tbody>
+                      <tr><th scope="row">File</th><td>{{ .Database.Path }}</td></tr>
+                      <tr><th scope="row">Size</th><td>{{ .Database.Size }} bytes</td></tr>
+                      <tr><th scope="row">Modified</th><td>{{ .Database.Modified }}</td></tr>
+                      <tr><th scope="row">SHA-256</th><td><code>{{ .Database.SHA256 }}</code></td></tr>
+                      <tr><th scope="row">Plasmids</th><td>{{ .Run.Plasmids }}</td></tr>
+                      <tr><th scope="row">k-mer / sketch</th><td>{{ .Run.Kmer }} / {{ .Run.SketchSize }}</td></tr>{{ if .Run.Hash }}
+                      <tr><th scope="row">Hash function</th><td>{{ .Run.Hash }}</td></tr>{{ end }}
+                    </tbody>
+                  </table>
+                </div>
+              </div>
+              <div class="row">
+                <div class="col-md-6">
+                  <h5>Phylum</h5>
+                  <table class="table table-sm">
+                    <tbody>{{ range .Phyla }}
+                      <tr>
+                        <td>{{ .Phylum }}</td>
+                        <td style="width:50%"><div class="bar" style="width:{{ .Percent }}%"></div></td>
+                        <td>{{ .Contigs }}</td>
+                        <td>{{ .Bases }} bp</td>
+                      </tr>{{ end }}
+                    </tbody>
+                  </table>
+                </div>
+                <div class="col-md-6">
+                  <h5>Similarity</h5>
+                  <div class="histogram">{{ range .Histogram }}
+                    <div class="bin{{ if .Pass }} pass{{ end }}" style="height:{{ .Percent }}%" title="{{ .Lower }}-{{ .Upper }}: {{ .Count }}"></div>{{ end }}
+                  </div>
+                  <div class="d-flex justify-content-between text-muted"><span>0</span><span>0.5</span><span>1</span></div>
+                </div>
+              </div>
+{{ if .Candidates }}
+              <div class="col-xs-12" style="height:30px;"></div>
+              <h5>Plasmid candidates by copy number</h5>
+              <table class="table table-sm" id="candidates">
+                <thead>
+                  <tr>
+                    <th scope="col" class="sortable">Plasmid</th>
+                    <th scope="col" class="sortable">Phylum</th>
+                    <th scope="col" class="sortable" data-type="number">Contigs</th>
+                    <th scope="col" class="sortable" data-type="number">Bases</th>
+                    <th scope="col" class="sortable" data-type="number">Coverage</th>
+                    <th scope="col" class="sortable" data-type="number">Copy number</th>
+                  </tr>
+                </thead>
+                <tbody>{{ range .Candidates }}
+                  <tr>
+                    <td>{{ .AccID }}</td>
+                    <td>{{ .Phylum }}</td>
+                    <td>{{ .Contigs }}</td>
+                    <td>{{ .Bases }}</td>
+                    <td>{{ printf "%.1f" .Coverage }}</td>
+                    <td>{{ printf "%.2f" .CopyNumber }}</td>
+                  </tr>{{ end }}
+                </tbody>
+              </table>
+{{ end }}
               <div class="col-xs-12" style="height:30px;"></div>
               <div class="bs-component">
-                <table class="table table-hover">
+                <input type="search" class="form-control mb-2" placeholder="Filter" data-filter="hits">
+                <table class="table table-hover" id="hits">
                   <thead>
                     <tr>
-                      <th scope="col">Contig ID 
-                      </th>
-                      <th scope="col">Sequence 
-                      </th>
-                      <th scope="col">Length 
-                      </th>
-                      <th scope="col">Similar plasmid 
-                      </th>
-                      <th scope="col">Phylum 
-                      </th>
-                      <th scope="col">Jaccard index 
-                      </th>
+                      <th scope="col" class="sortable">Contig ID</th>
+                      <th scope="col" class="sortable">Sequence</th>
+                      <th scope="col" class="sortable" data-type="number">Length</th>
+                      <th scope="col" class="sortable">Similar plasmid</th>
+                      <th scope="col" class="sortable">Phylum</th>
+                      <th scope="col" class="sortable" data-type="number">Jaccard index</th>{{ if .Candidates }}
+                      <th scope="col" class="sortable" data-type="number">Copy number</th>{{ end }}{{ if .Run.Chromosomes }}
+                      <th scope="col" class="sortable">Class</th>{{ end }}{{ if .Run.Replicons }}
+                      <th scope="col" class="sortable">Replicons</th>{{ end }}{{ if .Run.Resistance }}
+                      <th scope="col" class="sortable">Resistance genes (coverage / identity)</th>{{ end }}
                     </tr>
                   </thead>
-                  <tbody>{{range .}} 
+                  <tbody>{{ range .Rows }}
                     <tr>
-                      <td>{{ .AccID }} 
-                      </th>
-                      <td>{{ .Seq }} 
-                      </th>
-                      <td>{{ .Length }} 
-                      </th>
-                      <td>{{ .Link }} 
-                      </th>
-                      <td>{{ .Phylum }} 
-                      </th>
-                      <td>{{ .Jaccard }} 
-                      </th>
-                    </tr>{{end}} 
+                      <td>{{ .AccID }}</td>
+                      <td>{{ .Seq }}</td>
+                      <td>{{ .Length }}</td>
+                      <td>{{ range $i, $hit := .Hits }}{{ if $i }}<br>{{ end }}{{ if $hit.URL }}<a href="{{ $hit.URL }}" target="_blank">{{ $hit.AccID }}</a>{{ else }}{{ $hit.AccID }}{{ end }}{{ end }}</td>
+                      <td>{{ .Phylum }}</td>
+                      <td>{{ .Jaccard }}</td>{{ if $.Candidates }}
+                      <td>{{ if .CopyNumber }}{{ printf "%.2f" .CopyNumber }}{{ end }}</td>{{ end }}{{ if $.Run.Chromosomes }}
+                      <td>{{ .Class }}</td>{{ end }}{{ if $.Run.Replicons }}
+                      <td>{{ range $i, $hit := .Replicons }}{{ if $i }}<br>{{ end }}<span title="{{ $hit.Name }} (coverage {{ printf "%.2f" $hit.Coverage }}, identity {{ printf "%.3f" $hit.Identity }})">{{ $hit.Group }}</span>{{ end }}</td>{{ end }}{{ if $.Run.Resistance }}
+                      <td>{{ range $i, $hit := .Resistance }}{{ if $i }}<br>{{ end }}<span title="{{ $hit.Name }}">{{ $hit.Group }}</span> <small class="text-muted">{{ printf "%.2f" $hit.Coverage }} / {{ printf "%.3f" $hit.Identity }}</small>{{ end }}</td>{{ end }}
+                    </tr>{{ end }}
                   </tbody>
                 </table>
               </div>
@@ -72,5 +185,5 @@
       <span class="text-muted">https://github.com/haradama/pHash</span>
     </div>
   </footer>
-  <script src="assets/bootstrap.min.js"></script>
-</html>
\ No newline at end of file
+  <script>{{ .Assets.Script }}</script>
+</html>
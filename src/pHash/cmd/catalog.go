@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const databaseExt = ".phash"
+
+// defaultCatalog is used unless init --catalog points to another one, and its
+// entries also verify downloads of their URL without --db, such as that of
+// plain init. Values that are not known for a release are left zero: init
+// checks the others against the downloaded database. A built-in entry without
+// sha256 is checked against its other values alone, with a warning; entries
+// of other catalogs without sha256 are installed only with --no-verify.
+var defaultCatalog = `{
+  "databases": [
+    {
+      "name": "plasmiddb",
+      "version": "11062018",
+      "url": "https://zenodo.org/record/1991549/files/plasmidDB11062018.phash",
+      "records": 12576,
+      "description": "RefSeq complete plasmids (doi:10.5281/zenodo.1991549)"
+    }
+  ]
+}`
+
+type (
+	catalogEntry struct {
+		Name        string `json:"name"`
+		Version     string `json:"version"`
+		URL         string `json:"url"`
+		Kmer        int    `json:"kmer"`
+		SketchSize  uint64 `json:"sketch_size"`
+		Records     int    `json:"records"`
+		SHA256      string `json:"sha256"`
+		Description string `json:"description"`
+
+		builtin bool // listed in defaultCatalog
+	}
+
+	catalog struct {
+		Databases []catalogEntry `json:"databases"`
+	}
+)
+
+func defaultDataDir() string {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "phash")
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".local", "share", "phash")
+	}
+	return "phash"
+}
+
+func parseCatalog(b []byte) (catalog, error) {
+	var c catalog
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid catalog: %v", err)
+	}
+	for _, entry := range c.Databases {
+		if entry.Name == "" || entry.Version == "" || entry.URL == "" {
+			return c, fmt.Errorf("invalid catalog: name, version and url are required for every database")
+		}
+	}
+	return c, nil
+}
+
+// find returns the entry for "NAME@VERSION", or the latest version of NAME.
+func (c catalog) find(ref string) (catalogEntry, error) {
+	name, version := splitDatabaseRef(ref)
+
+	var found []catalogEntry
+	for _, entry := range c.Databases {
+		if entry.Name == name && (version == "" || entry.Version == version) {
+			found = append(found, entry)
+		}
+	}
+	if len(found) == 0 {
+		return catalogEntry{}, fmt.Errorf("%s is not in the catalog (see pHash init --list)", ref)
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return compareVersions(found[i].Version, found[j].Version) > 0
+	})
+	return found[0], nil
+}
+
+// builtinCatalog parses defaultCatalog.
+func builtinCatalog() (catalog, error) {
+	c, err := parseCatalog([]byte(defaultCatalog))
+	for i := range c.Databases {
+		c.Databases[i].builtin = true
+	}
+	return c, err
+}
+
+// builtinEntry returns the entry of the built-in catalog for a URL.
+func builtinEntry(url string) (catalogEntry, bool) {
+	c, err := builtinCatalog()
+	if err != nil {
+		return catalogEntry{}, false
+	}
+	for _, entry := range c.Databases {
+		if entry.URL == url {
+			return entry, true
+		}
+	}
+	return catalogEntry{}, false
+}
+
+func (e catalogEntry) fileName() string {
+	return e.Name + "@" + e.Version + databaseExt
+}
+
+func splitDatabaseRef(ref string) (string, string) {
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+// compareVersions orders versions by their numeric and non-numeric parts, so
+// that "1.10" sorts after "1.9".
+func compareVersions(a string, b string) int {
+	split := func(s string) []string {
+		var parts []string
+		for len(s) > 0 {
+			i := 1
+			digit := s[0] >= '0' && s[0] <= '9'
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9') == digit {
+				i++
+			}
+			parts = append(parts, s[:i])
+			s = s[i:]
+		}
+		return parts
+	}
+
+	pa, pb := split(a), split(b)
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.Atoi(pa[i])
+		nb, errB := strconv.Atoi(pb[i])
+		switch {
+		case errA == nil && errB == nil && na != nb:
+			if na < nb {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && pa[i] != pb[i]:
+			return strings.Compare(pa[i], pb[i])
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+// resolveDatabase returns path as is if it names a file, and otherwise looks
+// up an installed "NAME" or "NAME@VERSION" in the data directory.
+func resolveDatabase(fsys fileSystem, path string, dataDir string) (string, error) {
+	if _, err := fsys.Stat(path); err == nil {
+		return path, nil
+	}
+
+	name, version := splitDatabaseRef(path)
+	if version != "" {
+		installed := filepath.Join(dataDir, name+"@"+version+databaseExt)
+		if _, err := fsys.Stat(installed); err != nil {
+			return "", fmt.Errorf("database %s is neither a file nor installed in %s", path, dataDir)
+		}
+		return installed, nil
+	}
+
+	files, err := fsys.ReadDir(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	var latest string
+	for _, file := range files {
+		n, v := splitDatabaseRef(strings.TrimSuffix(file.Name(), databaseExt))
+		if n != name || !strings.HasSuffix(file.Name(), databaseExt) {
+			continue
+		}
+		if latest == "" || compareVersions(v, latest) > 0 {
+			latest = v
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("database %s is neither a file nor installed in %s", path, dataDir)
+	}
+	return filepath.Join(dataDir, name+"@"+latest+databaseExt), nil
+}
+
+func loadCatalog(ctx context.Context, client *http.Client, location string) (catalog, error) {
+	if location == "" {
+		return builtinCatalog()
+	}
+
+	var (
+		b   []byte
+		err error
+	)
+	if strings.Contains(location, "://") {
+		var (
+			req  *http.Request
+			resp *http.Response
+		)
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return catalog{}, err
+		}
+		resp, err = client.Do(req)
+		if err != nil {
+			return catalog{}, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return catalog{}, fmt.Errorf("GET %s: %s", location, resp.Status)
+		}
+		b, err = ioutil.ReadAll(resp.Body)
+	} else {
+		b, err = ioutil.ReadFile(location)
+	}
+	if err != nil {
+		return catalog{}, err
+	}
+
+	return parseCatalog(b)
+}
+
+func printCatalog(w io.Writer, c catalog, dataDir string) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tVERSION\tK\tSKETCH\tRECORDS\tINSTALLED\tDESCRIPTION")
+
+	unknown := func(n int) string {
+		if n == 0 {
+			return "-"
+		}
+		return strconv.Itoa(n)
+	}
+	for _, entry := range c.Databases {
+		installed := "no"
+		if _, err := os.Stat(filepath.Join(dataDir, entry.fileName())); err == nil {
+			installed = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", entry.Name, entry.Version, unknown(entry.Kmer), unknown(int(entry.SketchSize)), unknown(entry.Records), installed, entry.Description)
+	}
+	tw.Flush()
+}
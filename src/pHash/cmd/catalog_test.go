@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.10", "1.9", 1},
+		{"1.9", "1.10", -1},
+		{"11062018", "11062018", 0},
+		{"2", "10", -1},
+		{"1.0", "1.0.1", -1},
+		{"1.0b", "1.0a", 1},
+	}
+	for _, tt := range tests {
+		got := compareVersions(tt.a, tt.b)
+		if (got > 0) != (tt.want > 0) || (got < 0) != (tt.want < 0) {
+			t.Errorf("compareVersions(%q, %q) = %d, want the sign of %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCatalogFind(t *testing.T) {
+	c, err := parseCatalog([]byte(`{"databases": [
+		{"name": "db", "version": "1.9", "url": "u19"},
+		{"name": "db", "version": "1.10", "url": "u110"},
+		{"name": "db", "version": "1.2", "url": "u12"},
+		{"name": "other", "version": "2", "url": "o2"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ref  string
+		want string // URL, empty for an error
+	}{
+		{"db", "u110"},
+		{"db@1.9", "u19"},
+		{"other", "o2"},
+		{"db@3", ""},
+		{"missing", ""},
+	}
+	for _, tt := range tests {
+		entry, err := c.find(tt.ref)
+		if tt.want == "" {
+			if err == nil || !strings.Contains(err.Error(), tt.ref+" is not in the catalog") {
+				t.Errorf("find(%q) = %v, %v, want an error", tt.ref, entry, err)
+			}
+		} else if err != nil || entry.URL != tt.want {
+			t.Errorf("find(%q) = %v, %v, want %s", tt.ref, entry.URL, err, tt.want)
+		}
+	}
+}
+
+func TestResolveDatabase(t *testing.T) {
+	fsys := newMemFS()
+	for _, name := range []string{"db@1.9.phash", "db@1.10.phash", "db@1.2.phash", "dbx@9.phash", "notes.txt"} {
+		fsys.write(filepath.Join("data", name), "")
+	}
+	fsys.write("local.phash", "")
+
+	tests := []struct {
+		ref  string
+		want string // path, empty for an error
+	}{
+		{"local.phash", "local.phash"},
+		{"db", "data/db@1.10.phash"},
+		{"db@1.9", "data/db@1.9.phash"},
+		{"dbx", "data/dbx@9.phash"},
+		{"db@2", ""},
+		{"missing", ""},
+	}
+	for _, tt := range tests {
+		path, err := resolveDatabase(fsys, tt.ref, "data")
+		if tt.want == "" {
+			if err == nil || err.Error() != fmt.Sprintf("database %s is neither a file nor installed in data", tt.ref) {
+				t.Errorf("resolveDatabase(%q) = %q, %v, want an error", tt.ref, path, err)
+			}
+		} else if err != nil || path != tt.want {
+			t.Errorf("resolveDatabase(%q) = %q, %v, want %s", tt.ref, path, err, tt.want)
+		}
+	}
+}
+
+func TestInitCatalog(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	db := fsys.read(t, testDB)
+	sum := sha256.Sum256([]byte(db))
+	srv, _ := newDatabaseServer(t, map[string]string{"/synthetic.phash": db})
+
+	tests := []struct {
+		name     string
+		entry    string
+		noVerify bool
+		want     string // error, empty for success
+	}{
+		{name: "verified", entry: `"sha256": "` + hex.EncodeToString(sum[:]) + `", "kmer": 16, "sketch_size": 128, "records": 4`},
+		{name: "unverified", want: "no checksum for syn@1: no sha256 is given"},
+		{name: "no-verify", noVerify: true},
+		{name: "parameters", entry: `"kmer": 21`, noVerify: true, want: "syn@1 does not match the catalog: k=16, sketch size 128 and 4 records, the catalog lists k=21"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			entry := `"name": "syn", "version": "1", "url": "` + srv.URL + `/synthetic.phash"`
+			if tt.entry != "" {
+				entry += ", " + tt.entry
+			}
+			catalog := filepath.Join(dir, "catalog.json")
+			if err := ioutil.WriteFile(catalog, []byte(`{"databases": [{`+entry+`}]}`), 0666); err != nil {
+				t.Fatal(err)
+			}
+
+			var stdout strings.Builder
+			opts := &initOptions{Catalog: catalog, DB: "syn", DataDir: dir, NoVerify: tt.noVerify, Client: srv.Client()}
+			err := runInit(context.Background(), opts, &stdout, ioutil.Discard)
+			installed, _ := resolveDatabase(osFS{}, "syn", dir)
+			if tt.want != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("got error %v, want %q", err, tt.want)
+				}
+				if installed != "" {
+					t.Errorf("%s was installed", installed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("init: %v", err)
+			}
+			if installed != filepath.Join(dir, "syn@1.phash") {
+				t.Errorf("installed %q", installed)
+			}
+			if !strings.Contains(stdout.String(), "Installed syn@1 (k=16, sketch size 128, 4 records)\n") {
+				t.Errorf("init printed\n%s", stdout.String())
+			}
+		})
+	}
+}
+
+// TestInitBuiltin installs a database from the URL of a built-in catalog
+// entry, as plain init does, with no flags.
+func TestInitBuiltin(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	db := fsys.read(t, testDB)
+	sum := sha256.Sum256([]byte(db))
+	srv, _ := newDatabaseServer(t, map[string]string{"/synthetic.phash": db})
+
+	tests := []struct {
+		name   string
+		entry  string
+		want   string // error, empty for success
+		stderr string
+	}{
+		{name: "pinned", entry: `"sha256": "` + hex.EncodeToString(sum[:]) + `", "kmer": 16, "sketch_size": 128, "records": 4`},
+		{name: "pinned mismatch", entry: `"sha256": "` + strings.Repeat("0", 64) + `"`, want: "checksum mismatch for synthetic.phash"},
+		{name: "unpinned", entry: `"records": 4`, stderr: "Warning: no SHA-256 is pinned for syn@1"},
+		{name: "unpinned mismatch", entry: `"records": 5`, want: "syn@1 does not match the catalog"},
+	}
+	defer func(c string) { defaultCatalog = c }(defaultCatalog)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := srv.URL + "/synthetic.phash"
+			defaultCatalog = `{"databases": [{"name": "syn", "version": "1", "url": "` + url + `", ` + tt.entry + `}]}`
+			dir := t.TempDir()
+			wd, _ := os.Getwd()
+			if err := os.Chdir(dir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(wd)
+
+			var stdout, stderr strings.Builder
+			err := runInit(context.Background(), &initOptions{URL: url, DataDir: dir, Client: srv.Client()}, &stdout, &stderr)
+			if tt.want != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("got error %v, want %q", err, tt.want)
+				}
+				if _, err := os.Stat("synthetic.phash"); err == nil {
+					t.Error("a database failing its catalog entry was installed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("init: %v", err)
+			}
+			if got := fsys.read(t, testDB); mustReadFile(t, "synthetic.phash") != got {
+				t.Error("the installed database differs from the served one")
+			}
+			if !strings.Contains(stdout.String(), "Installed syn@1 (k=16, sketch size 128, 4 records)\n") {
+				t.Errorf("init printed\n%s", stdout.String())
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("init warned\n%s\nwant %q", stderr.String(), tt.stderr)
+			}
+		})
+	}
+}
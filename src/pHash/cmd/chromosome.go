@@ -0,0 +1,27 @@
+package cmd
+
+// Classes of a contig compared against both the plasmid and the chromosome
+// sketches of a database.
+const (
+	classPlasmid      = "plasmid"
+	classChromosome   = "chromosome"
+	classAmbiguous    = "ambiguous"
+	classUnclassified = "unclassified"
+)
+
+// classifyContig labels a contig by the margin between its best plasmid and
+// best chromosome similarity. Contigs reaching the threshold in neither are
+// unclassified, and contigs within the margin, such as transposons shared by
+// plasmids and chromosomes, are ambiguous.
+func classifyContig(plasmid float32, chromosome float32, threshold float32, margin float32) (string, float32) {
+	diff := plasmid - chromosome
+	switch {
+	case plasmid < threshold && chromosome < threshold:
+		return classUnclassified, diff
+	case diff >= margin:
+		return classPlasmid, diff
+	case -diff >= margin:
+		return classChromosome, diff
+	}
+	return classAmbiguous, diff
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestClassifyContig(t *testing.T) {
+	tests := []struct {
+		plasmid, chromosome float32
+		want                string
+	}{
+		{plasmid: 0.9, chromosome: 0.1, want: classPlasmid},
+		{plasmid: 0.2, chromosome: 0.8, want: classChromosome},
+		{plasmid: 0.6, chromosome: 0.58, want: classAmbiguous},
+		{plasmid: 0.05, chromosome: 0, want: classUnclassified},
+		{plasmid: 0.12, chromosome: 0, want: classPlasmid},
+	}
+
+	for _, tt := range tests {
+		got, _ := classifyContig(tt.plasmid, tt.chromosome, 0.1, 0.05)
+		if got != tt.want {
+			t.Errorf("classifyContig(%v, %v) = %s, want %s", tt.plasmid, tt.chromosome, got, tt.want)
+		}
+	}
+}
+
+// TestIdentifyChromosomes labels a plasmid, a chromosomal contig carrying a
+// transposon shared with the plasmid, and a plasmid integrated into the
+// chromosome.
+func TestIdentifyChromosomes(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	transposon := randomSequence(rng, 1000)
+	plasmid := randomSequence(rng, 1500) + transposon + randomSequence(rng, 1500)
+	integrated := randomSequence(rng, 4000)
+	chromosome := randomSequence(rng, 9000) + transposon + randomSequence(rng, 10000) + integrated + randomSequence(rng, 6000)
+
+	fsys := newMemFS()
+	fsys.write("refs.fna", formatFasta([]fastaRecord{{id: "NZ_PLS0001.1", seq: plasmid}, {id: "NZ_PLS0002.1", seq: integrated}}))
+	fsys.write("chromosomes.fna", formatFasta([]fastaRecord{{id: "NZ_CHR0001.1", seq: chromosome}}))
+	fsys.write(testQueries, formatFasta([]fastaRecord{
+		{id: "plasmid", seq: plasmid},
+		{id: "chromosomal", seq: chromosome[8000:12000]},
+		{id: "integrated", seq: integrated},
+	}))
+
+	dbOpts := &makedbOptions{In: "refs.fna", Chromosomes: "chromosomes.fna", Chunk: 4000, Out: testDB, Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runMakedb(context.Background(), dbOpts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+
+	opts := defaultIdentifyOptions(fsys)
+	opts.Format = "jsonl"
+	opts.Margin = 5
+	if err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	records, err := readResults(strings.NewReader(fsys.read(t, "out/pHash.jsonl")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"plasmid": classPlasmid, "chromosomal": classChromosome, "integrated": classAmbiguous}
+	for _, record := range records {
+		if record.Class != want[record.ContigID] {
+			t.Errorf("%s is %s (plasmid %.3f, chromosome %.3f), want %s", record.ContigID, record.Class, record.Similarity, record.ChromosomeSimilarity, want[record.ContigID])
+		}
+	}
+	if len(records) != len(want) {
+		t.Errorf("got %d records, want %d", len(records), len(want))
+	}
+
+	written := mustParseFasta(t, fsys.read(t, "out/pHash_plasmids.fna"))
+	if _, ok := written["chromosomal"]; ok || len(written) != 2 || written["plasmid"] != plasmid || written["integrated"] != integrated {
+		t.Errorf("plasmid FASTA has %d contigs, want plasmid and integrated but not the chromosomal contig", len(written))
+	}
+}
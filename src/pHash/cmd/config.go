@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// Flag values are layered as follows, the first one found wins:
+//
+//	command-line flag
+//	PHASH_<COMMAND>_<FLAG> environment variable (e.g. PHASH_IDENTIFY_THRESHOLD)
+//	PHASH_<FLAG> environment variable, for shared flags (e.g. PHASH_DATA_DIR)
+//	<command>: section of the config file
+//	top-level key of the config file, for shared flags
+//	built-in default
+//
+// Flag names map to keys as is ("data-dir") and to environment variables in
+// upper case with dashes replaced by underscores ("PHASH_DATA_DIR"). Nested
+// commands have nested sections ("db: derep:") and variables with every
+// command name ("PHASH_DB_DEREP_THRESHOLD").
+//
+// Flags such as --threshold and --kmer mean different things in different
+// commands, so only the shared flags, which mean the same in every command,
+// can be set for all commands at once.
+
+const envPrefix = "PHASH_"
+
+var sharedFlags = []string{"data-dir", "force"}
+
+var (
+	optConfig string
+
+	configCmd = &cobra.Command{
+		Use:   "config",
+		Short: "Inspect configuration",
+		Long:  "Inspect configuration from the config file and PHASH_* environment variables",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	configShowCmd = &cobra.Command{
+		Use:   "show [command...]",
+		Short: "Print effective default values",
+		Long:  "Print effective default values of every command and where they come from",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, path, err := loadConfig(optConfig)
+			if err != nil {
+				return err
+			}
+			if path != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "# config: %s\n", path)
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 2, ' ', 0)
+			fmt.Fprintln(tw, "COMMAND\tFLAG\tVALUE\tSOURCE")
+			walkCommands(RootCmd, func(sub *cobra.Command) {
+				path := commandPath(sub)
+				if len(args) > 0 && strings.Join(path, " ") != strings.Join(args, " ") {
+					return
+				}
+				sub.Flags().VisitAll(func(f *pflag.Flag) {
+					if f.Name == "help" {
+						return
+					}
+					value, source, ok := c.lookup(path, f.Name)
+					if !ok {
+						value, source = f.DefValue, "default"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", strings.Join(path, " "), f.Name, value, source)
+				})
+			})
+			tw.Flush()
+
+			for _, key := range c.unknownKeys() {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s in %s matches no flag (top-level keys are limited to %s)\n", key, path, strings.Join(sharedFlags, ", "))
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	RootCmd.PersistentFlags().StringVar(&optConfig, "config", "", "Config file (default $PHASH_CONFIG or <user config dir>/phash/config.yaml)")
+	RootCmd.PersistentPreRunE = applyConfig
+	RootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configShowCmd)
+}
+
+type config map[string]interface{}
+
+func defaultConfigPath() string {
+	if path := os.Getenv(envPrefix + "CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "phash", "config.yaml")
+}
+
+// loadConfig reads the config file at path, or at the default location if
+// path is empty. Only an explicitly given file has to exist.
+func loadConfig(path string) (config, string, error) {
+	explicit := path != ""
+	if !explicit {
+		path = defaultConfigPath()
+		if path == "" {
+			return config{}, "", nil
+		}
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && !explicit {
+		return config{}, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	c := config{}
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, "", fmt.Errorf("%s: %v", path, err)
+	}
+	return c, path, nil
+}
+
+func envName(parts ...string) string {
+	name := envPrefix + strings.Join(parts, "_")
+	return strings.ToUpper(strings.Replace(name, "-", "_", -1))
+}
+
+// commandPath lists the names of a command and its parents below the root,
+// e.g. ["db", "derep"].
+func commandPath(cmd *cobra.Command) []string {
+	return strings.Fields(cmd.CommandPath())[1:]
+}
+
+// walkCommands calls fn for every command below cmd.
+func walkCommands(cmd *cobra.Command, fn func(*cobra.Command)) {
+	for _, sub := range cmd.Commands() {
+		fn(sub)
+		walkCommands(sub, fn)
+	}
+}
+
+// lookup returns the configured value of a flag of the command at path and
+// its source.
+func (c config) lookup(path []string, flag string) (string, string, bool) {
+	value, source, ok := c.lookupValue(path, flag)
+	if !ok {
+		return "", "", false
+	}
+	return configString(value), source, true
+}
+
+// lookupValue is lookup with the value as found: a string from the
+// environment, or a scalar or list from the config file.
+func (c config) lookupValue(path []string, flag string) (interface{}, string, bool) {
+	shared := containsString(sharedFlags, flag)
+
+	names := []string{envName(append(append([]string{}, path...), flag)...)}
+	if shared {
+		names = append(names, envName(flag))
+	}
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			return value, name, true
+		}
+	}
+
+	section := map[interface{}]interface{}{}
+	for key, value := range c {
+		section[key] = value
+	}
+	for _, name := range path {
+		sub, ok := section[name].(map[interface{}]interface{})
+		if !ok {
+			section = nil
+			break
+		}
+		section = sub
+	}
+	if value, ok := section[flag]; ok {
+		if _, isSection := value.(map[interface{}]interface{}); !isSection {
+			return value, "config " + strings.Join(path, ".") + "." + flag, true
+		}
+	}
+	if value, ok := c[flag]; ok && shared {
+		if _, isSection := value.(map[interface{}]interface{}); !isSection {
+			return value, "config " + flag, true
+		}
+	}
+
+	return nil, "", false
+}
+
+func configString(value interface{}) string {
+	if list, ok := value.([]interface{}); ok {
+		return strings.Join(configItems(list), ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// configItems returns the items of a list in the config file, or of a
+// comma-separated value, for flags that take several values.
+func configItems(value interface{}) []string {
+	list, ok := value.([]interface{})
+	if !ok {
+		s := fmt.Sprint(value)
+		if s == "" {
+			return nil
+		}
+		items, err := csv.NewReader(strings.NewReader(s)).Read()
+		if err != nil {
+			return []string{s}
+		}
+		return items
+	}
+	items := make([]string, len(list))
+	for i, item := range list {
+		items[i] = fmt.Sprint(item)
+	}
+	return items
+}
+
+// unknownKeys lists the keys of the config file that set no flag: sections
+// of no command, keys of a section that match no flag of its command, and
+// top-level keys other than shared flags.
+func (c config) unknownKeys() []string {
+	var unknown []string
+	var walk func(cmd *cobra.Command, prefix string, section map[interface{}]interface{})
+	walk = func(cmd *cobra.Command, prefix string, section map[interface{}]interface{}) {
+		for k, value := range section {
+			key := fmt.Sprint(k)
+			if sub, ok := value.(map[interface{}]interface{}); ok {
+				var command *cobra.Command
+				for _, c := range cmd.Commands() {
+					if c.Name() == key {
+						command = c
+					}
+				}
+				if command == nil {
+					unknown = append(unknown, prefix+key)
+				} else {
+					walk(command, prefix+key+".", sub)
+				}
+				continue
+			}
+			if cmd == RootCmd {
+				if !containsString(sharedFlags, key) {
+					unknown = append(unknown, key)
+				}
+			} else if cmd.Flags().Lookup(key) == nil {
+				unknown = append(unknown, prefix+key)
+			}
+		}
+	}
+
+	root := map[interface{}]interface{}{}
+	for key, value := range c {
+		root[key] = value
+	}
+	walk(RootCmd, "", root)
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+func applyConfig(cmd *cobra.Command, args []string) error {
+	c, path, err := loadConfig(optConfig)
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Changed || f.Name == "help" || f.Name == "config" {
+			return
+		}
+		raw, source, ok := c.lookupValue(commandPath(cmd), f.Name)
+		if !ok {
+			return
+		}
+		value := configString(raw)
+		// Set would take the whole list as one item of flags such as
+		// serve --db.
+		var err error
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			err = slice.Replace(configItems(raw))
+		} else {
+			err = f.Value.Set(value)
+		}
+		if err != nil {
+			if strings.HasPrefix(source, "config") {
+				source = path + ": " + source[len("config "):]
+			}
+			errs = append(errs, fmt.Sprintf("invalid value %q for --%s from %s: %v", value, f.Name, source, err))
+		}
+	})
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const testConfig = `
+data-dir: /top
+threshold: 20
+identify:
+  data-dir: /section
+  threshold: 30
+  format: jsonl
+db:
+  derep:
+    threshold: 90
+`
+
+func parseTestConfig(t *testing.T, s string) config {
+	t.Helper()
+	c := config{}
+	if err := yaml.Unmarshal([]byte(s), &c); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestConfigLookup(t *testing.T) {
+	c := parseTestConfig(t, testConfig)
+
+	tests := []struct {
+		name   string
+		path   []string
+		flag   string
+		env    map[string]string
+		value  string
+		source string // empty when the default is used
+	}{
+		{name: "section", path: []string{"identify"}, flag: "threshold", value: "30", source: "config identify.threshold"},
+		{name: "command env over section", path: []string{"identify"}, flag: "threshold", env: map[string]string{"PHASH_IDENTIFY_THRESHOLD": "50"}, value: "50", source: "PHASH_IDENTIFY_THRESHOLD"},
+		{name: "flag env only for shared flags", path: []string{"identify"}, flag: "threshold", env: map[string]string{"PHASH_THRESHOLD": "40"}, value: "30", source: "config identify.threshold"},
+		{name: "top-level only for shared flags", path: []string{"evaluate"}, flag: "threshold"},
+		{name: "shared flag env over section", path: []string{"identify"}, flag: "data-dir", env: map[string]string{"PHASH_DATA_DIR": "/env"}, value: "/env", source: "PHASH_DATA_DIR"},
+		{name: "command env over flag env", path: []string{"identify"}, flag: "data-dir", env: map[string]string{"PHASH_DATA_DIR": "/env", "PHASH_IDENTIFY_DATA_DIR": "/cmd"}, value: "/cmd", source: "PHASH_IDENTIFY_DATA_DIR"},
+		{name: "section over top-level", path: []string{"identify"}, flag: "data-dir", value: "/section", source: "config identify.data-dir"},
+		{name: "top-level", path: []string{"tree"}, flag: "data-dir", value: "/top", source: "config data-dir"},
+		{name: "nested section", path: []string{"db", "derep"}, flag: "threshold", value: "90", source: "config db.derep.threshold"},
+		{name: "nested command env", path: []string{"db", "derep"}, flag: "threshold", env: map[string]string{"PHASH_DB_DEREP_THRESHOLD": "80"}, value: "80", source: "PHASH_DB_DEREP_THRESHOLD"},
+		{name: "default", path: []string{"makedb"}, flag: "kmer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			value, source, ok := c.lookup(tt.path, tt.flag)
+			if ok != (tt.source != "") || value != tt.value || source != tt.source {
+				t.Errorf("lookup(%v, %s) = %q, %q, %v, want %q from %q", tt.path, tt.flag, value, source, ok, tt.value, tt.source)
+			}
+		})
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := ioutil.WriteFile(path, []byte(testConfig), 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer func(saved string) { optConfig = saved }(optConfig)
+	optConfig = path
+
+	root := &cobra.Command{Use: "pHash"}
+	identify := newIdentifyCmd()
+	root.AddCommand(identify)
+	if err := identify.Flags().Set("threshold", "70"); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyConfig(identify, nil); err != nil {
+		t.Fatalf("applyConfig: %v", err)
+	}
+
+	want := map[string]string{"threshold": "70", "format": "jsonl", "data-dir": "/section"}
+	for flag, value := range want {
+		if f := identify.Flags().Lookup(flag); f.Value.String() != value {
+			t.Errorf("--%s = %q, want %q", flag, f.Value.String(), value)
+		}
+	}
+}
+
+func TestApplyConfigList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := ioutil.WriteFile(path, []byte("serve:\n  db:\n    - plasmids=a.phash\n    - b.phash\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer func(saved string) { optConfig = saved }(optConfig)
+	optConfig = path
+
+	tests := []struct {
+		name string
+		env  string
+		want []string
+	}{
+		{"config", "", []string{"plasmids=a.phash", "b.phash"}},
+		{"environment", "c.phash,plasmids=d.phash", []string{"c.phash", "plasmids=d.phash"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != "" {
+				t.Setenv("PHASH_SERVE_DB", tt.env)
+			}
+			root := &cobra.Command{Use: "pHash"}
+			serve := newServeCmd()
+			root.AddCommand(serve)
+			if err := applyConfig(serve, nil); err != nil {
+				t.Fatalf("applyConfig: %v", err)
+			}
+			got, err := serve.Flags().GetStringArray("db")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("--db = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigUnknownKeys(t *testing.T) {
+	c := parseTestConfig(t, testConfig+`    bogus: 1
+nocommand:
+  kmer: 16
+tree:
+  method: nj
+`)
+	want := []string{"db.derep.bogus", "nocommand", "threshold"}
+	if got := c.unknownKeys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unknownKeys() = %v, want %v", got, want)
+	}
+}
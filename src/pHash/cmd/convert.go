@@ -0,0 +1,480 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// phashSeed is the seed recorded in exported signatures of k-mins sketches,
+// the seed of their first value.
+const phashSeed = 0
+
+// sourmashHashFunction names MurmurHash3 in sourmash signatures. Databases
+// of murmur3 bottom-k sketches are exported under it with bottomKSeed, and
+// are compared by sourmash like its own.
+const sourmashHashFunction = "0.murmur64"
+
+type (
+	// sourmashSignature is a signature of the sourmash JSON format.
+	sourmashSignature struct {
+		Class        string            `json:"class"`
+		Email        string            `json:"email"`
+		HashFunction string            `json:"hash_function"`
+		Filename     string            `json:"filename"`
+		Name         string            `json:"name"`
+		License      string            `json:"license"`
+		Signatures   []sourmashMinHash `json:"signatures"`
+		Version      float64           `json:"version"`
+	}
+
+	sourmashMinHash struct {
+		Num      uint64   `json:"num"`
+		Ksize    int      `json:"ksize"`
+		Seed     uint64   `json:"seed"`
+		MaxHash  uint64   `json:"max_hash"`
+		Mins     []uint64 `json:"mins"`
+		Md5sum   string   `json:"md5sum"`
+		Molecule string   `json:"molecule"`
+
+		// Abundances are the counts of the k-mers of Mins in sketches
+		// with track_abundance.
+		Abundances []uint32 `json:"abundances,omitempty"`
+	}
+
+	// mashDump is the JSON written by mash info -d.
+	mashDump struct {
+		Kmer         int          `json:"kmer"`
+		Alphabet     string       `json:"alphabet"`
+		PreserveCase bool         `json:"preserveCase"`
+		Canonical    bool         `json:"canonical"`
+		SketchSize   uint64       `json:"sketchSize"`
+		HashType     string       `json:"hashType"`
+		HashBits     int          `json:"hashBits"`
+		HashSeed     uint64       `json:"hashSeed"`
+		Sketches     []mashSketch `json:"sketches"`
+	}
+
+	mashSketch struct {
+		Name   string   `json:"name"`
+		Hashes []uint64 `json:"hashes"`
+	}
+)
+
+type exportOptions struct {
+	DB      string
+	DataDir string
+	Out     string
+	Meta    string
+	Force   bool
+	FS      fileSystem
+}
+
+type importOptions struct {
+	In       string
+	Metadata string
+	Out      string
+	Kmer     int
+	Force    bool
+	FS       fileSystem
+}
+
+func init() {
+	dbCmd.AddCommand(newExportCmd())
+	dbCmd.AddCommand(newImportCmd())
+}
+
+func newExportCmd() *cobra.Command {
+	opts := &exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exporter of database to sourmash signatures",
+		Long: `Exporter of the plasmid sketches of a database to sourmash JSON signatures.
+
+Databases built with makedb --hash murmur3 --scheme bottomk hold the sketches
+of sourmash and Mash, the smallest MurmurHash3 values of the k-mers under seed
+42, and are exported as sourmash signatures of 0.murmur64. Sketches of other
+schemes cannot be compared with those of sourmash: their signatures name the
+pHash scheme in hash_function, such as phash.xxhash64.kmins, so that sourmash
+does not compare them with its own, and are only read back by db import.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Database file, sketch file, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Signature file")
+	cmd.Flags().StringVar(&opts.Meta, "meta", "", "Write accessions, phyla and members to this CSV file for import")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite existing output files")
+
+	return cmd
+}
+
+func newImportCmd() *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Importer of sourmash signatures and Mash sketches",
+		Long: `Importer of sourmash JSON signatures and Mash sketches into a database.
+
+Sourmash signatures of 0.murmur64 with seed 42 and a num sketch size, such as
+those of sourmash sketch dna -p num=1000, and Mash sketches dumped by
+mash info -d with 64-bit hashes become databases of murmur3 bottom-k
+sketches, which identify, dist and tree compare like those of makedb --hash
+murmur3 --scheme bottomk. Signatures written by db export of other schemes
+round-trip. Scaled sourmash sketches, sketches of other hash functions or
+seeds and 32-bit Mash sketches are refused with an error.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Signature file, or Mash JSON dump")
+	cmd.Flags().StringVarP(&opts.Metadata, "meta", "m", "", "Metadata CSV file (accession,phylum[,members]), such as that of export --meta")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Database")
+	cmd.Flags().IntVarP(&opts.Kmer, "kmer", "k", 0, "Length of k-mer of the sketches to import from signatures with several")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runExport(opts *exportOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.DB == "" || opts.Out == "" {
+		return errors.New("--db and --out are required")
+	}
+	fsys := orOSFS(opts.FS)
+	outputs := []string{opts.Out}
+	if opts.Meta != "" {
+		outputs = append(outputs, opts.Meta)
+	}
+	if err := checkOutputs(fsys, outputs, opts.Force); err != nil {
+		return err
+	}
+
+	plasmids, path, err := loadDatabase(fsys, opts.DB, opts.DataDir)
+	if err != nil {
+		return err
+	}
+	if len(plasmids.Chromosome) > 0 {
+		fmt.Fprintf(stderr, "Warning: the %d chromosome chunks of %s are not exported\n", len(plasmids.Chromosome), path)
+	}
+	if len(plasmids.Mask) > 0 {
+		fmt.Fprintf(stderr, "Warning: the mask of %d k-mers of %s is not exported, so databases imported from it do not mask queries\n", len(plasmids.Mask), path)
+	}
+
+	seed := uint64(phashSeed)
+	if plasmids.Scheme != "" {
+		seed = bottomKSeed
+	}
+	signatures := make([]sourmashSignature, 0, len(plasmids.Plasmid))
+	for _, record := range plasmids.Plasmid {
+		signatures = append(signatures, sourmashSignature{
+			Class:        "sourmash_signature",
+			HashFunction: signatureHashFunction(plasmids.Hash, plasmids.Scheme),
+			Filename:     filepath.Base(path),
+			Name:         record.AccID,
+			License:      "CC0",
+			Signatures: []sourmashMinHash{{
+				Num:        plasmids.SketchSize,
+				Ksize:      plasmids.Kmer,
+				Seed:       seed,
+				Mins:       record.PlasmidMinHashValue,
+				Md5sum:     signatureMd5(plasmids.Kmer, record.PlasmidMinHashValue),
+				Molecule:   "DNA",
+				Abundances: record.Abundances,
+			}},
+			Version: 0.4,
+		})
+	}
+
+	f, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := json.NewEncoder(f).Encode(signatures); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if opts.Meta == "" {
+		return nil
+	}
+	mf, err := createOutput(fsys, opts.Meta, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer mf.Close()
+	if err := writeMembers(mf, plasmids.Plasmid); err != nil {
+		return err
+	}
+	return mf.Close()
+}
+
+// signatureHashFunction names the hash function and scheme of a database in
+// signatures: that of sourmash for murmur3 bottom-k sketches, and
+// phash.<hash>.<scheme> for the others.
+func signatureHashFunction(hash string, scheme string) string {
+	if hashName(hash) == "murmur3" && schemeName(scheme) == "bottomk" {
+		return sourmashHashFunction
+	}
+	return "phash." + hashName(hash) + "." + schemeName(scheme)
+}
+
+// signatureScheme returns the database headers of the hash function and
+// scheme of a hash_function and the seed of its sketches, or false for a
+// hash_function that names none.
+func signatureScheme(hashFunction string) (string, string, uint64, bool) {
+	if hashFunction == sourmashHashFunction {
+		return "murmur3", "bottomk", bottomKSeed, true
+	}
+	parts := strings.Split(hashFunction, ".")
+	if len(parts) != 3 || parts[0] != "phash" {
+		return "", "", 0, false
+	}
+	hasher, err := lookupHasher(parts[1])
+	if err != nil {
+		return "", "", 0, false
+	}
+	scheme, err := lookupScheme(parts[2])
+	if err != nil {
+		return "", "", 0, false
+	}
+	hash, header := hashHeader(hasher), schemeHeader(scheme)
+	if header == "" {
+		return hash, header, phashSeed, true
+	}
+	if signatureHashFunction(hash, header) != hashFunction {
+		return "", "", 0, false
+	}
+	return hash, header, bottomKSeed, true
+}
+
+// signatureMd5 is the checksum sourmash gives a sketch: the MD5 of the k-mer
+// length and the sorted values written in decimal.
+func signatureMd5(k int, values []uint64) string {
+	sorted := append([]uint64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	h := md5.New()
+	io.WriteString(h, strconv.Itoa(k))
+	for _, v := range sorted {
+		io.WriteString(h, strconv.FormatUint(v, 10))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func runImport(opts *importOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.Out == "" {
+		return errors.New("--in and --out are required")
+	}
+	fsys := orOSFS(opts.FS)
+	if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+		return err
+	}
+
+	metadata := map[string]PlasmidRecord{}
+	if opts.Metadata != "" {
+		f, err := fsys.Open(opts.Metadata)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if metadata, err = readMembers(f); err != nil {
+			return err
+		}
+	}
+
+	binary, err := readFile(fsys, opts.In)
+	if err != nil {
+		return err
+	}
+	plasmids, err := parseSignatures(binary, opts.In, opts.Kmer)
+	if err != nil {
+		return err
+	}
+	for i := range plasmids.Plasmid {
+		if record, ok := metadata[plasmids.Plasmid[i].AccID]; ok {
+			plasmids.Plasmid[i].Phylum = record.Phylum
+			plasmids.Plasmid[i].Members = record.Members
+		}
+	}
+
+	buf, err := messagePackEncoding(&plasmids)
+	if err != nil {
+		return err
+	}
+	f, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(buf); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "Imported %d sketches (k=%d, sketch=%d, hash=%s, scheme=%s)\n", len(plasmids.Plasmid), plasmids.Kmer, plasmids.SketchSize, hashName(plasmids.Hash), schemeName(plasmids.Scheme))
+	return nil
+}
+
+// parseSignatures reads sourmash signatures, a list of them or one, or a Mash
+// JSON dump, and checks that their sketches are of a scheme pHash compares.
+// With k > 0 only the sketches of that k-mer length are read.
+func parseSignatures(binary []byte, path string, k int) (Plasmids, error) {
+	trimmed := bytes.TrimSpace(binary)
+	if len(trimmed) == 0 || trimmed[0] != '[' && trimmed[0] != '{' {
+		return Plasmids{}, fmt.Errorf("%s is not JSON; import reads sourmash JSON signatures and Mash sketches dumped by mash info -d", path)
+	}
+
+	var signatures []sourmashSignature
+	if trimmed[0] == '{' {
+		var object struct {
+			sourmashSignature
+			mashDump
+		}
+		if err := json.Unmarshal(trimmed, &object); err != nil {
+			return Plasmids{}, fmt.Errorf("%s: %v", path, err)
+		}
+		switch {
+		case object.HashType != "":
+			return parseMashDump(object.mashDump, path, k)
+		case object.Signatures == nil:
+			return Plasmids{}, fmt.Errorf("%s is neither sourmash signatures nor a Mash dump", path)
+		}
+		signatures = []sourmashSignature{object.sourmashSignature}
+	} else if err := json.Unmarshal(trimmed, &signatures); err != nil {
+		return Plasmids{}, fmt.Errorf("%s: %v", path, err)
+	}
+
+	var plasmids Plasmids
+	for _, signature := range signatures {
+		if signature.Class != "" && signature.Class != "sourmash_signature" {
+			return Plasmids{}, fmt.Errorf("%s: %s is not a sourmash signature", path, signature.Name)
+		}
+		for _, sketch := range signature.Signatures {
+			if k > 0 && sketch.Ksize != k {
+				continue
+			}
+			hash, scheme, seed, ok := signatureScheme(signature.HashFunction)
+			if !ok || sketch.Seed != seed {
+				return Plasmids{}, fmt.Errorf("%s: %s is a sketch of %s with seed %d, which pHash cannot compare (%s)", path, signature.Name, signature.HashFunction, sketch.Seed, strings.Join(signatureHashFunctions(), ", "))
+			}
+			if sketch.Molecule != "" && !strings.EqualFold(sketch.Molecule, "DNA") {
+				return Plasmids{}, fmt.Errorf("%s: %s is a sketch of %s, not DNA", path, signature.Name, sketch.Molecule)
+			}
+			if sketch.Num == 0 {
+				return Plasmids{}, fmt.Errorf("%s: %s is a scaled sketch; pHash reads sketches of a fixed size, such as those of sourmash sketch dna -p num=1000", path, signature.Name)
+			}
+			mins := sketch.Mins
+			if scheme == "" && uint64(len(mins)) != sketch.Num || scheme != "" && uint64(len(mins)) > sketch.Num {
+				return Plasmids{}, fmt.Errorf("%s: %s has %d values for a sketch size of %d", path, signature.Name, len(mins), sketch.Num)
+			}
+			abundances := sketch.Abundances
+			if len(abundances) > 0 && (scheme == "" || len(abundances) != len(mins)) {
+				return Plasmids{}, fmt.Errorf("%s: %s has %d abundances for %d values of a %s sketch", path, signature.Name, len(abundances), len(mins), schemeName(scheme))
+			}
+			if scheme != "" {
+				mins, abundances = sortedSketch(mins, abundances)
+			}
+			if len(plasmids.Plasmid) == 0 {
+				plasmids.Kmer, plasmids.SketchSize, plasmids.Hash, plasmids.Scheme = sketch.Ksize, sketch.Num, hash, scheme
+				plasmids.Abundance = len(abundances) > 0
+			} else if plasmids.Abundance != (len(abundances) > 0) {
+				return Plasmids{}, fmt.Errorf("%s: %s has abundances unlike the sketches before, or the other way round", path, signature.Name)
+			} else if hash != plasmids.Hash || scheme != plasmids.Scheme {
+				return Plasmids{}, fmt.Errorf("%s: %s is a sketch of %s, unlike %s before", path, signature.Name, signature.HashFunction, signatureHashFunction(plasmids.Hash, plasmids.Scheme))
+			} else if sketch.Ksize != plasmids.Kmer || sketch.Num != plasmids.SketchSize {
+				return Plasmids{}, fmt.Errorf("%s: %s has k=%d and sketch size %d, unlike k=%d and sketch size %d before (select one k-mer length with --kmer)", path, signature.Name, sketch.Ksize, sketch.Num, plasmids.Kmer, plasmids.SketchSize)
+			}
+			plasmids.Plasmid = append(plasmids.Plasmid, PlasmidRecord{AccID: signature.Name, Phylum: "---", PlasmidMinHashValue: mins, Abundances: abundances})
+		}
+	}
+	if len(plasmids.Plasmid) == 0 {
+		return Plasmids{}, fmt.Errorf("%s holds no sketches to import", path)
+	}
+	return plasmids, nil
+}
+
+// parseMashDump reads the sketches of a Mash JSON dump, which are bottom-k
+// sketches of murmur3 if Mash hashed canonical k-mers into 64 bits with seed
+// 42, its defaults for k > 16.
+func parseMashDump(dump mashDump, path string, k int) (Plasmids, error) {
+	if dump.HashType != "MurmurHash3_x64_128" || dump.HashBits != 64 || dump.HashSeed != bottomKSeed {
+		return Plasmids{}, fmt.Errorf("%s: Mash sketches of %s (%d bits, seed %d) cannot be compared by pHash, which reads those of MurmurHash3_x64_128 (64 bits, seed %d)", path, dump.HashType, dump.HashBits, dump.HashSeed, bottomKSeed)
+	}
+	if !dump.Canonical || dump.PreserveCase || dump.Alphabet != "" && dump.Alphabet != "ACGT" {
+		return Plasmids{}, fmt.Errorf("%s: Mash sketches of alphabet %s must be canonical and ignore case", path, dump.Alphabet)
+	}
+	if k > 0 && dump.Kmer != k {
+		return Plasmids{}, fmt.Errorf("%s holds sketches of k=%d, not %d", path, dump.Kmer, k)
+	}
+	if dump.Kmer < 1 || dump.SketchSize < 1 {
+		return Plasmids{}, fmt.Errorf("%s: Mash dump without k-mer length or sketch size", path)
+	}
+
+	plasmids := Plasmids{SketchSize: dump.SketchSize, Kmer: dump.Kmer, Hash: "murmur3", Scheme: "bottomk"}
+	for _, sketch := range dump.Sketches {
+		if uint64(len(sketch.Hashes)) > dump.SketchSize {
+			return Plasmids{}, fmt.Errorf("%s: %s has %d values for a sketch size of %d", path, sketch.Name, len(sketch.Hashes), dump.SketchSize)
+		}
+		hashes, _ := sortedSketch(sketch.Hashes, nil)
+		plasmids.Plasmid = append(plasmids.Plasmid, PlasmidRecord{AccID: sketch.Name, Phylum: "---", PlasmidMinHashValue: hashes})
+	}
+	if len(plasmids.Plasmid) == 0 {
+		return Plasmids{}, fmt.Errorf("%s holds no sketches to import", path)
+	}
+	return plasmids, nil
+}
+
+// sortedSketch returns the distinct values of a bottom-k sketch in ascending
+// order, with the counts of repeated values added up if it has counts.
+func sortedSketch(values []uint64, counts []uint32) ([]uint64, []uint32) {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+
+	var distinct []uint64
+	var distinctCounts []uint32
+	for _, i := range order {
+		if n := len(distinct); n > 0 && values[i] == distinct[n-1] {
+			if counts != nil {
+				distinctCounts[n-1] += counts[i]
+			}
+			continue
+		}
+		distinct = append(distinct, values[i])
+		if counts != nil {
+			distinctCounts = append(distinctCounts, counts[i])
+		}
+	}
+	return distinct, distinctCounts
+}
+
+// signatureHashFunctions lists the schemes import reads.
+func signatureHashFunctions() []string {
+	var names []string
+	for _, hash := range hasherNames() {
+		for _, scheme := range schemeNames() {
+			names = append(names, signatureHashFunction(hash, scheme))
+		}
+	}
+	return names
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExportImport(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	exportImport(t, fsys, testDB)
+
+	// The exact query is a copy of NZ_SYN0001.1, which db derep merges it into.
+	fsys.write("all.fna", fsys.read(t, "refs.fna")+fsys.read(t, testQueries))
+	makedb := &makedbOptions{In: "all.fna", Metadata: testRefPhylum, Out: "all.phash", Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runMakedb(context.Background(), makedb, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+	derep := &derepOptions{DB: "all.phash", Out: "derep.phash", Threshold: 95, FS: fsys}
+	if err := runDerep(context.Background(), derep, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("derep: %v", err)
+	}
+	imported := exportImport(t, fsys, "derep.phash")
+	if members := imported.Plasmid[0].Members; imported.Plasmid[0].AccID != "NZ_SYN0001.1" || !reflect.DeepEqual(members, []string{"exact"}) {
+		t.Errorf("%s imported with members %v, want exact", imported.Plasmid[0].AccID, members)
+	}
+}
+
+// exportImport exports a database with its metadata, checks the signatures
+// and imports them, which must give the same database back.
+func exportImport(t *testing.T, fsys *memFS, db string) Plasmids {
+	t.Helper()
+	export := &exportOptions{DB: db, Out: "plasmids.sig", Meta: "plasmids.csv", Force: true, FS: fsys}
+	if err := runExport(export, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	var signatures []sourmashSignature
+	if err := json.Unmarshal([]byte(fsys.read(t, "plasmids.sig")), &signatures); err != nil {
+		t.Fatal(err)
+	}
+	binary := []byte(fsys.read(t, db))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signatures) != len(plasmids.Plasmid) {
+		t.Fatalf("got %d signatures, want %d", len(signatures), len(plasmids.Plasmid))
+	}
+	for i, sig := range signatures {
+		record := plasmids.Plasmid[i]
+		if sig.Class != "sourmash_signature" || sig.HashFunction != "phash.xxhash64.kmins" || sig.Name != record.AccID || len(sig.Signatures) != 1 {
+			t.Errorf("signature %d is %s of %s (%s) with %d sketches, want %s", i, sig.Class, sig.Name, sig.HashFunction, len(sig.Signatures), record.AccID)
+			continue
+		}
+		if mh := sig.Signatures[0]; mh.Num != 128 || mh.Ksize != 16 || mh.Seed != phashSeed || !reflect.DeepEqual(mh.Mins, record.PlasmidMinHashValue) {
+			t.Errorf("%s has num=%d ksize=%d seed=%d, want the sketch of the database", sig.Name, mh.Num, mh.Ksize, mh.Seed)
+		}
+	}
+
+	imp := &importOptions{In: "plasmids.sig", Metadata: "plasmids.csv", Out: "imported.phash", Force: true, FS: fsys}
+	if err := runImport(imp, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if fsys.read(t, "imported.phash") != fsys.read(t, db) {
+		t.Errorf("the database imported from %s differs from it", db)
+	}
+	return plasmids
+}
+
+func TestImportIncompatible(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "sourmash other seed",
+			in:   `[{"class":"sourmash_signature","hash_function":"0.murmur64","name":"plasmid","signatures":[{"num":3,"ksize":21,"seed":7,"mins":[1,2,3]}]}]`,
+			want: "plasmid is a sketch of 0.murmur64 with seed 7, which pHash cannot compare",
+		},
+		{
+			name: "sourmash scaled",
+			in:   `[{"class":"sourmash_signature","hash_function":"0.murmur64","name":"plasmid","signatures":[{"num":0,"ksize":21,"seed":42,"max_hash":18446744073709552,"mins":[1,2,3]}]}]`,
+			want: "plasmid is a scaled sketch",
+		},
+		{
+			name: "sourmash protein",
+			in:   `[{"class":"sourmash_signature","hash_function":"0.murmur64","name":"plasmid","signatures":[{"num":3,"ksize":21,"seed":42,"mins":[1,2,3],"molecule":"protein"}]}]`,
+			want: "plasmid is a sketch of protein, not DNA",
+		},
+		{
+			name: "mash 32-bit dump",
+			in:   `{"kmer":12,"alphabet":"ACGT","canonical":true,"sketchSize":1000,"hashType":"MurmurHash3_x86_32","hashBits":32,"hashSeed":42,"sketches":[]}`,
+			want: "Mash sketches of MurmurHash3_x86_32 (32 bits, seed 42) cannot be compared by pHash",
+		},
+		{
+			name: "mash dump without sketches",
+			in:   `{"kmer":21,"alphabet":"ACGT","canonical":true,"sketchSize":1000,"hashType":"MurmurHash3_x64_128","hashBits":64,"hashSeed":42,"sketches":[]}`,
+			want: "in.sig holds no sketches to import",
+		},
+		{
+			name: "mash binary",
+			in:   "\x00\x00\x00\x00binary",
+			want: "in.sig is not JSON; import reads sourmash JSON signatures and Mash sketches dumped by mash info -d",
+		},
+		{
+			name: "other object",
+			in:   `{"name":"a"}`,
+			want: "in.sig is neither sourmash signatures nor a Mash dump",
+		},
+		{
+			name: "mixed k-mer lengths",
+			in: `[{"hash_function":"phash.xxhash64.kmins","name":"a","signatures":[{"num":1,"ksize":16,"mins":[1]}]},
+			      {"hash_function":"phash.xxhash64.kmins","name":"b","signatures":[{"num":1,"ksize":21,"mins":[1]}]}]`,
+			want: "select one k-mer length with --kmer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newMemFS()
+			fsys.write("in.sig", tt.in)
+			err := runImport(&importOptions{In: "in.sig", Out: "out.phash", FS: fsys}, ioutil.Discard, ioutil.Discard)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// coveragePatterns find the read coverage of a contig in its FASTA header:
+// SPAdes and Velvet names (NODE_1_length_5120_cov_23.5), MEGAHIT descriptions
+// (multi=23.5) and generic cov=, coverage= or depth= descriptions.
+var (
+	nameCoveragePattern = regexp.MustCompile(`_cov_([0-9]+(?:\.[0-9]+)?(?:[eE][-+]?[0-9]+)?)`)
+	descCoveragePattern = regexp.MustCompile(`(?:^|\s)(?:multi|cov|coverage|depth)=([0-9]+(?:\.[0-9]+)?(?:[eE][-+]?[0-9]+)?)x?(?:\s|$)`)
+)
+
+func parseCoverage(name string, desc string) (float64, bool) {
+	for _, m := range [][]string{nameCoveragePattern.FindStringSubmatch(name), descCoveragePattern.FindStringSubmatch(desc)} {
+		if m == nil {
+			continue
+		}
+		if coverage, err := strconv.ParseFloat(m[1], 64); err == nil {
+			return coverage, true
+		}
+	}
+	return 0, false
+}
+
+type contigCoverage struct {
+	length   int
+	coverage float64
+}
+
+// baselineCoverage is the length-weighted median coverage of the contigs, an
+// estimate of the coverage of single-copy chromosomal sequence when contigs
+// identified as plasmids are left out.
+func baselineCoverage(contigs []contigCoverage) float64 {
+	sorted := append([]contigCoverage(nil), contigs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].coverage < sorted[j].coverage })
+
+	var total int
+	for _, c := range sorted {
+		total += c.length
+	}
+	var cumulative int
+	for _, c := range sorted {
+		cumulative += c.length
+		if 2*cumulative >= total {
+			return c.coverage
+		}
+	}
+	return 0
+}
+
+// rankCandidates groups report rows by best hit, highest copy number first.
+func rankCandidates(rows []reportRow, baseline float64) []plasmidCandidate {
+	index := map[string]int{}
+	var (
+		candidates []plasmidCandidate
+		weighted   []float64
+	)
+	for _, row := range rows {
+		if row.Coverage == 0 {
+			continue
+		}
+		for _, hit := range row.Hits {
+			i, ok := index[hit.AccID]
+			if !ok {
+				i = len(candidates)
+				index[hit.AccID] = i
+				candidates = append(candidates, plasmidCandidate{AccID: hit.AccID, Phylum: hit.Phylum})
+				weighted = append(weighted, 0)
+			}
+			candidates[i].Contigs++
+			candidates[i].Bases += row.Length
+			weighted[i] += row.Coverage * float64(row.Length)
+		}
+	}
+
+	for i := range candidates {
+		candidates[i].Coverage = weighted[i] / float64(candidates[i].Bases)
+		if baseline > 0 {
+			candidates[i].CopyNumber = candidates[i].Coverage / baseline
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Coverage != candidates[j].Coverage {
+			return candidates[i].Coverage > candidates[j].Coverage
+		}
+		return candidates[i].Bases > candidates[j].Bases
+	})
+
+	return candidates
+}
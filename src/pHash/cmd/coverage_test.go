@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestParseCoverage(t *testing.T) {
+	tests := []struct {
+		name, desc string
+		want       float64
+		ok         bool
+	}{
+		{name: "NODE_1_length_5120_cov_23.5", want: 23.5, ok: true},
+		{name: "NODE_12_length_301_cov_4", want: 4, ok: true},
+		{name: "k141_3", desc: "flag=1 multi=12.0000 len=5120", want: 12, ok: true},
+		{name: "contig_1", desc: "depth=1.5e2x", want: 150, ok: true},
+		{name: "contig_2", desc: "coverage=7", want: 7, ok: true},
+		{name: "contig_3", desc: "recovery=7"},
+		{name: "contig_4"},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseCoverage(tt.name, tt.desc)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseCoverage(%q, %q) = %v, %v, want %v, %v", tt.name, tt.desc, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBaselineCoverage(t *testing.T) {
+	contigs := []contigCoverage{
+		{length: 100000, coverage: 10},
+		{length: 5000, coverage: 200},
+		{length: 80000, coverage: 12},
+		{length: 1000, coverage: 1},
+	}
+	// The short outliers barely move the length-weighted median.
+	if got := baselineCoverage(contigs); got != 10 {
+		t.Errorf("baselineCoverage() = %v, want 10", got)
+	}
+	if got := baselineCoverage(nil); got != 0 {
+		t.Errorf("baselineCoverage(nil) = %v, want 0", got)
+	}
+}
+
+func TestRankCandidates(t *testing.T) {
+	rows := []reportRow{
+		{Length: 1000, Coverage: 30, Hits: []reportHit{{AccID: "A"}}},
+		{Length: 3000, Coverage: 10, Hits: []reportHit{{AccID: "A"}, {AccID: "B"}}},
+		{Length: 2000, Coverage: 40, Hits: []reportHit{{AccID: "C"}}},
+		{Length: 2000, Hits: []reportHit{{AccID: "D"}}},
+	}
+
+	got := rankCandidates(rows, 10)
+	want := []plasmidCandidate{
+		{AccID: "C", Contigs: 1, Bases: 2000, Coverage: 40, CopyNumber: 4},
+		{AccID: "A", Contigs: 2, Bases: 4000, Coverage: 15, CopyNumber: 1.5},
+		{AccID: "B", Contigs: 1, Bases: 3000, Coverage: 10, CopyNumber: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("candidate %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestIdentifyCoverageReport(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	opts := defaultIdentifyOptions(fsys)
+	opts.Coverage = true
+
+	if err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	results := fsys.results(t, "out/pHash.tsv")
+	copyNumbers := map[string]float64{"exact": 5, "fragment": 10, "mutated": 2, "chimera": 1.25, "unrelated": 1}
+	for contig, want := range copyNumbers {
+		record, ok := results[contig]
+		if !ok || record.CopyNumber == nil || *record.CopyNumber != want {
+			t.Errorf("%s has copy number %v, want %g", contig, formatOptionalFloat(record.CopyNumber), want)
+		}
+	}
+	if len(results) != len(copyNumbers) {
+		t.Errorf("got %d contigs, want %d", len(results), len(copyNumbers))
+	}
+
+	report := fsys.read(t, "out/pHash_report.html")
+	for _, want := range []string{"Plasmid candidates by copy number", "Baseline coverage", "<td>10.00</td>"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Tools for databases",
+	Long:  "Tools for plasmid databases",
+}
+
+type derepOptions struct {
+	DB        string
+	DataDir   string
+	Out       string
+	Members   string
+	Threshold int
+	Force     bool
+	FS        fileSystem
+}
+
+func init() {
+	RootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(newDerepCmd())
+}
+
+func newDerepCmd() *cobra.Command {
+	opts := &derepOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "derep",
+		Short: "Dereplicator of database",
+		Long:  "Dereplicator of database by greedy clustering of similar plasmids",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDerep(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Database file, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Dereplicated database")
+	cmd.Flags().StringVar(&opts.Members, "members", "", "Write representatives and their members to this CSV file")
+	cmd.Flags().IntVarP(&opts.Threshold, "threshold", "t", 95, "Similarity to join a cluster (percent)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite existing output files")
+
+	return cmd
+}
+
+func runDerep(ctx context.Context, opts *derepOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.DB == "" || opts.Out == "" {
+		return errors.New("--db and --out are required")
+	}
+	if opts.Threshold < 1 || opts.Threshold > 100 {
+		return errors.New("--threshold must be between 1 and 100")
+	}
+	fsys := orOSFS(opts.FS)
+
+	outputs := []string{opts.Out}
+	if opts.Members != "" {
+		outputs = append(outputs, opts.Members)
+	}
+	if err := checkOutputs(fsys, outputs, opts.Force); err != nil {
+		return err
+	}
+
+	plasmids, _, err := loadDatabase(fsys, opts.DB, opts.DataDir)
+	if err != nil {
+		return err
+	}
+	scheme, err := lookupScheme(plasmids.Scheme)
+	if err != nil {
+		return err
+	}
+
+	representatives, err := dereplicate(ctx, plasmids.Plasmid, scheme, plasmids.SketchSize, float32(opts.Threshold)/100)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "%d plasmids in %d clusters\n", len(plasmids.Plasmid), len(representatives))
+
+	plasmids.Plasmid = representatives
+	buf, err := messagePackEncoding(&plasmids)
+	if err != nil {
+		return err
+	}
+	file, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Write(buf); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if opts.Members == "" {
+		return nil
+	}
+	mf, err := createOutput(fsys, opts.Members, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer mf.Close()
+	if err := writeMembers(mf, representatives); err != nil {
+		return err
+	}
+	return mf.Close()
+}
+
+// dereplicate clusters records greedily in database order: a record joins
+// the cluster of the most similar representative reaching the threshold, or
+// becomes the representative of a new cluster. Representatives carry the
+// accessions of the other members, including those of records that were
+// themselves dereplicated before.
+func dereplicate(ctx context.Context, records []PlasmidRecord, scheme sketchScheme, sketchSize uint64, threshold float32) ([]PlasmidRecord, error) {
+	var representatives []PlasmidRecord
+	workers := runtime.NumCPU()
+
+	for _, record := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Each worker finds the best representative in its share.
+		best := make([]int, workers)
+		bestValue := make([]float32, workers)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			best[w] = -1
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := w; i < len(representatives); i += workers {
+					similarity := sketchSimilarity(scheme, record, representatives[i], sketchSize)
+					if similarity >= threshold && (best[w] < 0 || similarity > bestValue[w] || similarity == bestValue[w] && i < best[w]) {
+						best[w], bestValue[w] = i, similarity
+					}
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		cluster := -1
+		var value float32
+		for w := range best {
+			if best[w] >= 0 && (cluster < 0 || bestValue[w] > value || bestValue[w] == value && best[w] < cluster) {
+				cluster, value = best[w], bestValue[w]
+			}
+		}
+
+		if cluster < 0 {
+			representatives = append(representatives, record)
+			continue
+		}
+		representative := &representatives[cluster]
+		representative.Members = append(representative.Members, record.AccID)
+		representative.Members = append(representative.Members, record.Members...)
+	}
+
+	return representatives, nil
+}
+
+// writeMembers writes a CSV file of accession, phylum and the members joined
+// with ";", which makedb reads as metadata and db import with the members.
+func writeMembers(w io.Writer, representatives []PlasmidRecord) error {
+	cw := csv.NewWriter(w)
+	for _, record := range representatives {
+		if err := cw.Write([]string{record.AccID, record.Phylum, strings.Join(record.Members, ";")}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// readMembers reads a CSV file written by writeMembers into records with the
+// phylum and members of each accession. Rows without members, such as those
+// of makedb metadata, give records without members.
+func readMembers(r io.Reader) (map[string]PlasmidRecord, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	records := map[string]PlasmidRecord{}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) < 2 {
+			continue
+		}
+		record := PlasmidRecord{AccID: row[0], Phylum: row[1]}
+		if len(row) >= 3 && row[2] != "" {
+			record.Members = strings.Split(row[2], ";")
+		}
+		records[row[0]] = record
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDerep(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	a, b, c := randomSequence(rng, 4000), randomSequence(rng, 4000), randomSequence(rng, 4000)
+
+	fsys := newMemFS()
+	fsys.write("refs.fna", formatFasta([]fastaRecord{
+		{id: "A1", seq: a},
+		{id: "B1", seq: b},
+		{id: "A2", seq: mutate(rng, a, 0.0005)},
+		{id: "C1", seq: c},
+		{id: "B2", seq: b},
+		{id: "A3", seq: a[:3000]},
+	}))
+	fsys.write("refs.csv", "A1,Proteobacteria\nB1,Firmicutes\n")
+	opts := &makedbOptions{In: "refs.fna", Metadata: "refs.csv", Out: testDB, Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+
+	derep := &derepOptions{DB: testDB, Out: "derep.phash", Members: "members.csv", Threshold: 95, FS: fsys}
+	if err := runDerep(context.Background(), derep, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("derep: %v", err)
+	}
+
+	binary := []byte(fsys.read(t, "derep.phash"))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for _, record := range plasmids.Plasmid {
+		got = append(got, append([]string{record.AccID}, record.Members...))
+	}
+	// A3 is only 75% of A and stays on its own.
+	want := [][]string{{"A1", "A2"}, {"B1", "B2"}, {"C1"}, {"A3"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("clusters %v, want %v", got, want)
+	}
+	if plasmids.Kmer != 16 || plasmids.SketchSize != 128 {
+		t.Errorf("got k=%d sketch=%d, want 16 and 128", plasmids.Kmer, plasmids.SketchSize)
+	}
+
+	wantMembers := "A1,Proteobacteria,A2\nB1,Firmicutes,B2\nC1,---,\nA3,---,\n"
+	if got := fsys.read(t, "members.csv"); got != wantMembers {
+		t.Errorf("members.csv is\n%s\nwant\n%s", got, wantMembers)
+	}
+
+	// The threshold is a percentage, as in identify: A3 joins A at 70%.
+	derep = &derepOptions{DB: testDB, Out: "derep.phash", Threshold: 70, Force: true, FS: fsys}
+	if err := runDerep(context.Background(), derep, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("derep: %v", err)
+	}
+	binary = []byte(fsys.read(t, "derep.phash"))
+	if plasmids, err = messagePackDecoding(&binary); err != nil {
+		t.Fatal(err)
+	}
+	if len(plasmids.Plasmid) != 3 || !reflect.DeepEqual(plasmids.Plasmid[0].Members, []string{"A2", "A3"}) {
+		t.Errorf("got %d clusters, the first with members %v, want 3 and A2, A3", len(plasmids.Plasmid), plasmids.Plasmid[0].Members)
+	}
+
+	for _, threshold := range []int{0, 101} {
+		derep := &derepOptions{DB: testDB, Out: "derep.phash", Threshold: threshold, Force: true, FS: fsys}
+		if err := runDerep(context.Background(), derep, ioutil.Discard, ioutil.Discard); err == nil || err.Error() != "--threshold must be between 1 and 100" {
+			t.Errorf("got error %v for --threshold %d", err, threshold)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+type distOptions struct {
+	DB            string
+	DB2           string
+	DataDir       string
+	Format        string
+	Metric        string
+	MinSimilarity int
+	Weighted      bool
+	Out           string
+	Force         bool
+	FS            fileSystem
+}
+
+var (
+	distFormats = []string{"phylip", "square", "long"}
+	distMetrics = []string{"jaccard", "mash"}
+)
+
+func init() {
+	RootCmd.AddCommand(newDistCmd())
+}
+
+func newDistCmd() *cobra.Command {
+	opts := &distOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "dist",
+		Short: "Distance matrix of database",
+		Long:  "Pairwise distances among the plasmids of a database, or between two databases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDist(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Database file, sketch file, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DB2, "db2", "", "Second database or sketch file to compare the first one with")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "long", "Output format (phylip, square or long)")
+	cmd.Flags().StringVar(&opts.Metric, "metric", "jaccard", "Distance (jaccard: 1 - similarity, or mash)")
+	cmd.Flags().IntVar(&opts.MinSimilarity, "min-similarity", 0, "Only write pairs with at least this similarity in percent (long format)")
+	cmd.Flags().BoolVar(&opts.Weighted, "weighted", false, "Weight similarities by the k-mer counts of databases built with --abundance")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Output file (default standard output)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runDist(ctx context.Context, opts *distOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.DB == "" {
+		return errors.New("--db is required")
+	}
+	if !containsString(distFormats, opts.Format) {
+		return fmt.Errorf("--format must be one of %s", strings.Join(distFormats, ", "))
+	}
+	if !containsString(distMetrics, opts.Metric) {
+		return fmt.Errorf("--metric must be one of %s", strings.Join(distMetrics, ", "))
+	}
+	if opts.MinSimilarity < 0 || opts.MinSimilarity > 100 {
+		return errors.New("--min-similarity must be between 0 and 100")
+	}
+	if opts.MinSimilarity > 0 && opts.Format != "long" {
+		return errors.New("--min-similarity only applies to --format long")
+	}
+	if opts.DB2 != "" && opts.Format == "phylip" {
+		return errors.New("--format phylip needs a square matrix and cannot be used with --db2")
+	}
+	fsys := orOSFS(opts.FS)
+
+	rows, _, err := loadDatabase(fsys, opts.DB, opts.DataDir)
+	if err != nil {
+		return err
+	}
+	cols := rows
+	if opts.DB2 != "" {
+		if cols, _, err = loadDatabase(fsys, opts.DB2, opts.DataDir); err != nil {
+			return err
+		}
+		if cols.Kmer != rows.Kmer || cols.SketchSize != rows.SketchSize {
+			return fmt.Errorf("databases differ in k-mer length or sketch size (k=%d, sketch=%d and k=%d, sketch=%d)", rows.Kmer, rows.SketchSize, cols.Kmer, cols.SketchSize)
+		}
+		if cols.Hash != rows.Hash {
+			return fmt.Errorf("databases differ in hash function (%s and %s)", hashName(rows.Hash), hashName(cols.Hash))
+		}
+		if cols.Scheme != rows.Scheme {
+			return fmt.Errorf("databases differ in sketch scheme (%s and %s)", schemeName(rows.Scheme), schemeName(cols.Scheme))
+		}
+		if cols.maskID() != rows.maskID() {
+			fmt.Fprintln(stderr, "Warning: the databases were sketched with different masks")
+		}
+	}
+	if opts.Weighted && !(rows.Abundance && cols.Abundance) {
+		return errors.New("--weighted needs databases or sketch files with abundances (makedb or sketch --abundance)")
+	}
+	scheme, err := lookupScheme(rows.Scheme)
+	if err != nil {
+		return err
+	}
+
+	if opts.Out != "" {
+		if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+			return err
+		}
+		f, err := createOutput(fsys, opts.Out, opts.Force)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		stdout = f
+	}
+	w := bufio.NewWriter(stdout)
+
+	minSimilarity := float32(opts.MinSimilarity) / 100
+	distance := func(similarity float32) float64 {
+		return sketchDistance(opts.Metric, similarity, rows.Kmer)
+	}
+	// Within one database only the upper triangle of the long format is
+	// needed.
+	long := opts.Format == "long"
+	from := func(i int) int {
+		if long && opts.DB2 == "" {
+			return i + 1
+		}
+		return 0
+	}
+
+	switch opts.Format {
+	case "phylip":
+		fmt.Fprintf(w, "%d\n", len(rows.Plasmid))
+	case "square":
+		for _, record := range cols.Plasmid {
+			fmt.Fprintf(w, "\t%s", record.AccID)
+		}
+		fmt.Fprintln(w)
+	case "long":
+		fmt.Fprintln(w, "reference1\treference2\tsimilarity\tdistance")
+	}
+
+	err = computeRows(ctx, len(rows.Plasmid), func(i int) []float32 {
+		row := make([]float32, len(cols.Plasmid))
+		for j := from(i); j < len(cols.Plasmid); j++ {
+			if opts.Weighted {
+				row[j] = weightedSimilarity(rows.Plasmid[i], cols.Plasmid[j], rows.SketchSize)
+			} else {
+				row[j] = sketchSimilarity(scheme, rows.Plasmid[i], cols.Plasmid[j], rows.SketchSize)
+			}
+		}
+		return row
+	}, func(i int, row []float32) error {
+		name := rows.Plasmid[i].AccID
+		switch opts.Format {
+		case "phylip", "square":
+			sep := "\t"
+			if opts.Format == "phylip" {
+				sep = " "
+			}
+			fields := []string{name}
+			for _, similarity := range row {
+				fields = append(fields, strconv.FormatFloat(distance(similarity), 'f', 6, 64))
+			}
+			_, err := fmt.Fprintln(w, strings.Join(fields, sep))
+			return err
+		}
+		for j := from(i); j < len(row); j++ {
+			if row[j] < minSimilarity {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%.6f\t%.6f\n", name, cols.Plasmid[j].AccID, row[j], distance(row[j])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// loadDatabase reads a database file, a database installed by init or a
+// sketch file written by sketch, and returns it with the resolved path.
+func loadDatabase(fsys fileSystem, ref string, dataDir string) (Plasmids, string, error) {
+	path, err := resolveDatabase(fsys, ref, dataDir)
+	if err != nil {
+		return Plasmids{}, "", err
+	}
+	binary, err := readFile(fsys, path)
+	if err != nil {
+		return Plasmids{}, "", err
+	}
+	plasmids, err := messagePackDecoding(&binary)
+	if err == nil && len(plasmids.Plasmid) == 0 {
+		if sketches, err := decodeSketches(binary, path); err == nil {
+			return sketches.plasmids(), path, nil
+		}
+	}
+	return plasmids, path, err
+}
+
+// sketchSimilarity is the estimated Jaccard similarity of two records,
+// without the negative bias calcSimilarity gives sketches sharing nothing.
+func sketchSimilarity(scheme sketchScheme, a PlasmidRecord, b PlasmidRecord, sketchSize uint64) float32 {
+	similarity := calcSimilarity(scheme, a.PlasmidMinHashValue, b.PlasmidMinHashValue, sketchSize)
+	if similarity < 0 {
+		return 0
+	}
+	return similarity
+}
+
+// sketchDistance turns a Jaccard similarity into a distance: 1 - j, or the
+// Mash distance -ln(2j / (1 + j)) / k, which approximates the per-base
+// mutation rate and is capped at 1 for unrelated sequences.
+func sketchDistance(metric string, similarity float32, k int) float64 {
+	j := float64(similarity)
+	if metric != "mash" {
+		return 1 - j
+	}
+	if j <= 0 {
+		return 1
+	}
+	return math.Min(1, -math.Log(2*j/(1+j))/float64(k))
+}
+
+// computeRows computes rows from as many goroutines as CPUs and passes them
+// to write in order, so that large matrices are never held in memory.
+func computeRows(ctx context.Context, n int, compute func(i int) []float32, write func(i int, row []float32) error) error {
+	var (
+		wg       sync.WaitGroup
+		firstErr error
+		next     int
+		written  int
+	)
+	mutex := new(sync.Mutex)
+	pending := map[int][]float32{}
+
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = ctx.Err()
+				}
+				if firstErr != nil || next >= n {
+					mutex.Unlock()
+					return
+				}
+				i := next
+				next++
+				mutex.Unlock()
+
+				row := compute(i)
+
+				mutex.Lock()
+				pending[i] = row
+				for firstErr == nil {
+					row, ok := pending[written]
+					if !ok {
+						break
+					}
+					delete(pending, written)
+					firstErr = write(written, row)
+					written++
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
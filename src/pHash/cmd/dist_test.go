@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+func TestSketchDistance(t *testing.T) {
+	tests := []struct {
+		metric     string
+		similarity float32
+		want       float64
+	}{
+		{"jaccard", 1, 0},
+		{"jaccard", 0.25, 0.75},
+		{"mash", 1, 0},
+		{"mash", 0, 1},
+		{"mash", 0.5, -math.Log(2.0/3) / 16},
+	}
+	for _, tt := range tests {
+		if got := sketchDistance(tt.metric, tt.similarity, 16); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("sketchDistance(%s, %v) = %v, want %v", tt.metric, tt.similarity, got, tt.want)
+		}
+	}
+}
+
+func TestDist(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(opts *distOptions)
+		want   string
+	}{
+		{
+			name:   "phylip",
+			modify: func(opts *distOptions) { opts.Format = "phylip" },
+			want: `4
+NZ_SYN0001.1 0.000000 1.000000 1.000000 1.000000
+NZ_SYN0002.1 1.000000 0.000000 1.000000 1.000000
+NZ_SYN0003.1 1.000000 1.000000 0.000000 1.000000
+NZ_SYN0004.1 1.000000 1.000000 1.000000 0.000000
+`,
+		},
+		{
+			name:   "square",
+			modify: func(opts *distOptions) { opts.Format = "square" },
+			want: `	NZ_SYN0001.1	NZ_SYN0002.1	NZ_SYN0003.1	NZ_SYN0004.1
+NZ_SYN0001.1	0.000000	1.000000	1.000000	1.000000
+NZ_SYN0002.1	1.000000	0.000000	1.000000	1.000000
+NZ_SYN0003.1	1.000000	1.000000	0.000000	1.000000
+NZ_SYN0004.1	1.000000	1.000000	1.000000	0.000000
+`,
+		},
+		{
+			name:   "long",
+			modify: func(opts *distOptions) {},
+			want: `reference1	reference2	similarity	distance
+NZ_SYN0001.1	NZ_SYN0002.1	0.000000	1.000000
+NZ_SYN0001.1	NZ_SYN0003.1	0.000000	1.000000
+NZ_SYN0001.1	NZ_SYN0004.1	0.000000	1.000000
+NZ_SYN0002.1	NZ_SYN0003.1	0.000000	1.000000
+NZ_SYN0002.1	NZ_SYN0004.1	0.000000	1.000000
+NZ_SYN0003.1	NZ_SYN0004.1	0.000000	1.000000
+`,
+		},
+		{
+			name: "long against itself with a cut",
+			modify: func(opts *distOptions) {
+				opts.DB2 = testDB
+				opts.MinSimilarity = 50
+			},
+			want: `reference1	reference2	similarity	distance
+NZ_SYN0001.1	NZ_SYN0001.1	1.000000	0.000000
+NZ_SYN0002.1	NZ_SYN0002.1	1.000000	0.000000
+NZ_SYN0003.1	NZ_SYN0003.1	1.000000	0.000000
+NZ_SYN0004.1	NZ_SYN0004.1	1.000000	0.000000
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newSyntheticFS()
+			makeSyntheticDB(t, fsys)
+			opts := &distOptions{DB: testDB, Format: "long", Metric: "jaccard", Out: "dist.tsv", FS: fsys}
+			tt.modify(opts)
+
+			if err := runDist(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("dist: %v", err)
+			}
+			if got := fsys.read(t, "dist.tsv"); got != tt.want {
+				t.Errorf("output:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeRows(t *testing.T) {
+	compute := func(i int) []float32 { return []float32{float32(i)} }
+
+	var order []int
+	err := computeRows(context.Background(), 100, compute, func(i int, row []float32) error {
+		if row[0] != float32(i) {
+			t.Errorf("row %d holds %v", i, row)
+		}
+		order = append(order, i)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(order) != 100 {
+		t.Fatalf("wrote %d rows, want 100", len(order))
+	}
+	for i, row := range order {
+		if row != i {
+			t.Fatalf("row %d written at %d, want rows in order", row, i)
+		}
+	}
+
+	errWrite := errors.New("disk full")
+	var written int
+	err = computeRows(context.Background(), 100, compute, func(i int, row []float32) error {
+		written++
+		if i == 10 {
+			return errWrite
+		}
+		return nil
+	})
+	if err != errWrite || written != 11 {
+		t.Errorf("got error %v after %d rows, want %v after 11", err, written, errWrite)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = computeRows(ctx, 100, compute, func(i int, row []float32) error {
+		t.Errorf("row %d written after cancellation", i)
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestDistMinSimilarity(t *testing.T) {
+	// P2 shares 9 and P3 8 of the 10 hashes of P1.
+	plasmids := &Plasmids{SketchSize: 10, Kmer: 16, Plasmid: []PlasmidRecord{
+		{AccID: "P1", PlasmidMinHashValue: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{AccID: "P2", PlasmidMinHashValue: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 20}},
+		{AccID: "P3", PlasmidMinHashValue: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 30, 40}},
+	}}
+	b, err := messagePackEncoding(plasmids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := newMemFS()
+	fsys.write("db.phash", string(b))
+
+	var out bytes.Buffer
+	opts := &distOptions{DB: "db.phash", Format: "long", Metric: "jaccard", MinSimilarity: 90, FS: fsys}
+	if err := runDist(context.Background(), opts, &out, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	want := "reference1\treference2\tsimilarity\tdistance\nP1\tP2\t0.900000\t0.100000\n"
+	if out.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestDistErrors(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	for _, opts := range []*distOptions{
+		{DB: testDB, Format: "phylip", Metric: "jaccard", DB2: testDB},
+		{DB: testDB, Format: "square", Metric: "jaccard", MinSimilarity: 50},
+		{DB: testDB, Format: "long", Metric: "jaccard", MinSimilarity: 101},
+		{DB: testDB, Format: "long", Metric: "euclid"},
+	} {
+		opts.FS = fsys
+		if err := runDist(context.Background(), opts, ioutil.Discard, ioutil.Discard); err == nil {
+			t.Errorf("no error for %+v", opts)
+		}
+	}
+}
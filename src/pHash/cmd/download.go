@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var errNotFound = errors.New("not found")
+
+type progressWriter struct {
+	w       io.Writer
+	name    string
+	done    int64
+	total   int64
+	percent int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.done += int64(len(b))
+	if p.total > 0 {
+		if percent := 100 * p.done / p.total; percent != p.percent {
+			p.percent = percent
+			fmt.Fprintf(p.w, "\r%s: %.1f / %.1f MB (%d%%)", p.name, float64(p.done)/1e6, float64(p.total)/1e6, percent)
+		}
+	} else if p.done>>20 != (p.done-int64(len(b)))>>20 {
+		fmt.Fprintf(p.w, "\r%s: %.1f MB", p.name, float64(p.done)/1e6)
+	}
+	return len(b), nil
+}
+
+func (p *progressWriter) finish() {
+	fmt.Fprintln(p.w)
+}
+
+// newDownloadClient returns a client that also understands file:// URLs so
+// that a local directory can serve as a mirror.
+func newDownloadClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
+	return &http.Client{Transport: transport}
+}
+
+// partPath names the partial download of url into dest. The name depends on
+// the URL so that a partial file of another URL or version is not resumed.
+func partPath(dest string, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return dest + "." + hex.EncodeToString(sum[:6]) + ".part"
+}
+
+// downloadFile streams url into a partial file next to dest, resuming a
+// previous partial download when the server honours range requests. The
+// partial file is left in place for the caller to verify and rename.
+func downloadFile(ctx context.Context, client *http.Client, url string, dest string, progress io.Writer) (string, error) {
+	part := partPath(dest, url)
+
+	var offset int64
+	if stat, err := os.Stat(part); err == nil {
+		offset = stat.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	flag := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusOK:
+		offset = 0
+		flag |= os.O_TRUNC
+	case http.StatusPartialContent:
+		// Bytes from another offset would corrupt the partial file, which
+		// is then downloaded again.
+		if start, ok := contentRangeStart(resp.Header.Get("Content-Range")); !ok || start != offset {
+			resp.Body.Close()
+			if err := os.Remove(part); err != nil {
+				return "", err
+			}
+			return downloadFile(ctx, client, url, dest, progress)
+		}
+		flag |= os.O_APPEND
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file is complete only if it has the size of the
+		// file on the server. Otherwise it is downloaded again.
+		size, err := remoteSize(ctx, client, url, resp)
+		if err != nil {
+			return "", err
+		}
+		if size == offset {
+			return part, nil
+		}
+		if err := os.Remove(part); err != nil {
+			return "", err
+		}
+		return downloadFile(ctx, client, url, dest, progress)
+	case http.StatusNotFound:
+		return "", fmt.Errorf("GET %s: %w", url, errNotFound)
+	default:
+		return "", fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	file, err := os.OpenFile(part, flag, 0666)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	p := &progressWriter{w: progress, name: dest, done: offset}
+	if resp.ContentLength >= 0 {
+		p.total = offset + resp.ContentLength
+	}
+	_, err = io.Copy(file, io.TeeReader(resp.Body, p))
+	p.finish()
+	if err != nil {
+		return "", err
+	}
+
+	return part, file.Close()
+}
+
+// contentRangeStart returns the first byte of a Content-Range header
+// ("bytes <start>-<end>/<size>").
+func contentRangeStart(cr string) (int64, bool) {
+	if !strings.HasPrefix(cr, "bytes ") {
+		return 0, false
+	}
+	i := strings.Index(cr, "-")
+	if i < 0 {
+		return 0, false
+	}
+	start, err := strconv.ParseInt(cr[len("bytes "):i], 10, 64)
+	return start, err == nil
+}
+
+// remoteSize returns the size of the file at url from the Content-Range of a
+// 416 response ("bytes */<size>"), or else from a HEAD request, and -1 when
+// the server tells neither.
+func remoteSize(ctx context.Context, client *http.Client, url string, resp *http.Response) (int64, error) {
+	if cr := resp.Header.Get("Content-Range"); strings.HasPrefix(cr, "bytes */") {
+		if size, err := strconv.ParseInt(strings.TrimPrefix(cr, "bytes */"), 10, 64); err == nil {
+			return size, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	head, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	head.Body.Close()
+	if head.StatusCode != http.StatusOK {
+		return -1, nil
+	}
+	return head.ContentLength, nil
+}
+
+// fetchManifest reads a checksum manifest in the sha256sum format
+// ("<hex digest>  <file name>" per line).
+func fetchManifest(ctx context.Context, client *http.Client, url string) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("GET %s: %w", url, errNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	manifest := map[string]string{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		manifest[strings.TrimPrefix(fields[1], "*")] = strings.ToLower(fields[0])
+	}
+
+	return manifest, scanner.Err()
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
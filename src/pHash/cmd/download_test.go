@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newDatabaseServer serves files by path with range support, and records the
+// Range header of every GET.
+func newDatabaseServer(t *testing.T, files map[string]string) (*httptest.Server, *[]string) {
+	t.Helper()
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contents, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodGet {
+			ranges = append(ranges, r.Header.Get("Range"))
+		}
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, strings.NewReader(contents))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &ranges
+}
+
+func TestDownloadFile(t *testing.T) {
+	contents := strings.Repeat("0123456789", 100)
+	srv, ranges := newDatabaseServer(t, map[string]string{"/db.phash": contents, "/other.phash": "other"})
+	url := srv.URL + "/db.phash"
+
+	tests := []struct {
+		name   string
+		part   string // partial file left by an earlier download
+		ranges []string
+	}{
+		{name: "200", ranges: []string{""}},
+		{name: "206 resume", part: contents[:400], ranges: []string{"bytes=400-"}},
+		{name: "416 complete", part: contents, ranges: []string{"bytes=1000-"}},
+		{name: "416 larger", part: contents + "stale", ranges: []string{"bytes=1005-", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := filepath.Join(t.TempDir(), "db.phash")
+			if tt.part != "" {
+				if err := ioutil.WriteFile(partPath(dest, url), []byte(tt.part), 0666); err != nil {
+					t.Fatal(err)
+				}
+			}
+			*ranges = nil
+
+			part, err := downloadFile(context.Background(), srv.Client(), url, dest, ioutil.Discard)
+			if err != nil {
+				t.Fatalf("downloadFile: %v", err)
+			}
+			if b, _ := ioutil.ReadFile(part); string(b) != contents {
+				t.Errorf("downloaded %d bytes, want the %d bytes of the file", len(b), len(contents))
+			}
+			if strings.Join(*ranges, ",") != strings.Join(tt.ranges, ",") {
+				t.Errorf("requested ranges %q, want %q", *ranges, tt.ranges)
+			}
+		})
+	}
+
+	t.Run("404", func(t *testing.T) {
+		_, err := downloadFile(context.Background(), srv.Client(), srv.URL+"/missing.phash", filepath.Join(t.TempDir(), "db.phash"), ioutil.Discard)
+		if !errors.Is(err, errNotFound) {
+			t.Errorf("got error %v, want errNotFound", err)
+		}
+	})
+
+	t.Run("206 from another offset", func(t *testing.T) {
+		// A server that answers every range request from the start.
+		var got []string
+		wrong := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = append(got, r.Header.Get("Range"))
+			if r.Header.Get("Range") != "" {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(contents)-1, len(contents)))
+				w.WriteHeader(http.StatusPartialContent)
+			}
+			io.WriteString(w, contents)
+		}))
+		defer wrong.Close()
+
+		url := wrong.URL + "/db.phash"
+		dest := filepath.Join(t.TempDir(), "db.phash")
+		if err := ioutil.WriteFile(partPath(dest, url), []byte(contents[:400]), 0666); err != nil {
+			t.Fatal(err)
+		}
+		part, err := downloadFile(context.Background(), wrong.Client(), url, dest, ioutil.Discard)
+		if err != nil {
+			t.Fatalf("downloadFile: %v", err)
+		}
+		if b, _ := ioutil.ReadFile(part); string(b) != contents {
+			t.Errorf("downloaded %d bytes, want the %d bytes of the file", len(b), len(contents))
+		}
+		if want := []string{"bytes=400-", ""}; strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("requested ranges %q, want %q", got, want)
+		}
+	})
+
+	t.Run("other URL", func(t *testing.T) {
+		dest := filepath.Join(t.TempDir(), "db.phash")
+		if err := ioutil.WriteFile(partPath(dest, srv.URL+"/other.phash"), []byte("other"), 0666); err != nil {
+			t.Fatal(err)
+		}
+		part, err := downloadFile(context.Background(), srv.Client(), url, dest, ioutil.Discard)
+		if err != nil {
+			t.Fatalf("downloadFile: %v", err)
+		}
+		if b, _ := ioutil.ReadFile(part); string(b) != contents {
+			t.Error("the partial download of another URL was resumed")
+		}
+	})
+}
+
+func TestInitVerify(t *testing.T) {
+	contents := "database"
+	sum := sha256.Sum256([]byte(contents))
+	digest := hex.EncodeToString(sum[:])
+	srv, _ := newDatabaseServer(t, map[string]string{
+		"/db.phash":          contents,
+		"/listed/db.phash":   contents,
+		"/listed/SHA256SUMS": digest + "  db.phash\n",
+	})
+
+	tests := []struct {
+		name string
+		opts initOptions
+		want string // error, empty for success
+	}{
+		{name: "sha256", opts: initOptions{URL: srv.URL + "/db.phash", SHA256: strings.ToUpper(digest)}},
+		{name: "manifest", opts: initOptions{URL: srv.URL + "/listed/db.phash"}},
+		{name: "mismatch", opts: initOptions{URL: srv.URL + "/listed/db.phash", SHA256: strings.Repeat("0", 64)}, want: "checksum mismatch for db.phash"},
+		{name: "unverified", opts: initOptions{URL: srv.URL + "/db.phash"}, want: "no checksum for db.phash"},
+		{name: "no-verify", opts: initOptions{URL: srv.URL + "/db.phash", NoVerify: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			opts := tt.opts
+			opts.DataDir = dir
+			opts.Client = srv.Client()
+			// Without --db, init downloads into the working directory.
+			wd, _ := os.Getwd()
+			if err := os.Chdir(dir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(wd)
+
+			err := runInit(context.Background(), &opts, ioutil.Discard, ioutil.Discard)
+			if tt.want != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("got error %v, want %q", err, tt.want)
+				}
+				if _, err := os.Stat("db.phash"); err == nil {
+					t.Error("an unverified database was installed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("init: %v", err)
+			}
+			if b, err := ioutil.ReadFile("db.phash"); err != nil || !bytes.Equal(b, []byte(contents)) {
+				t.Errorf("installed %q, %v", b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+type evaluateOptions struct {
+	Truth     string
+	Result    string
+	Meta      string
+	Curve     string
+	Threshold int
+	Step      float64
+	Force     bool
+	FS        fileSystem
+}
+
+// truthLabel is the true source of a contig; AccID is empty for contigs not
+// derived from a plasmid.
+type truthLabel struct {
+	AccID  string
+	Phylum string
+}
+
+// prediction collects the tied best hits of a contig.
+type prediction struct {
+	similarity float32
+	accessions []string
+	phyla      []string
+}
+
+// confusion counts contigs at one threshold. A positive contig assigned to
+// the wrong label is both a false positive and a false negative; fpr only
+// counts calls on negative contigs.
+type confusion struct {
+	Level     string
+	Threshold float32
+	TP        int
+	FP        int
+	FN        int
+	TN        int
+	negFP     int
+}
+
+// evaluationLevels compare contigs by accession and by phylum.
+var evaluationLevels = []struct {
+	name   string
+	truth  func(l truthLabel) string
+	called func(p prediction) []string
+}{
+	{"accession", func(l truthLabel) string { return l.AccID }, func(p prediction) []string { return p.accessions }},
+	{"phylum", func(l truthLabel) string { return l.Phylum }, func(p prediction) []string { return p.phyla }},
+}
+
+func init() {
+	RootCmd.AddCommand(newEvaluateCmd())
+}
+
+func newEvaluateCmd() *cobra.Command {
+	opts := &evaluateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "evaluate",
+		Short: "Evaluator of identify results",
+		Long:  "Evaluator of identify results against the true source of each contig",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEvaluate(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Truth, "truth", "", "True labels: TSV/CSV (contig, accession[, phylum]) or FASTA from simulate")
+	cmd.Flags().StringVarP(&opts.Result, "result", "r", "", "Result of identify (tsv, csv or jsonl)")
+	cmd.Flags().StringVarP(&opts.Meta, "meta", "m", "", "Metadata CSV file (accession,phylum) for missing phyla")
+	cmd.Flags().StringVar(&opts.Curve, "curve", "", "Write metrics at every threshold to this TSV file")
+	cmd.Flags().IntVarP(&opts.Threshold, "threshold", "t", 10, "Threshold of probability to report")
+	cmd.Flags().Float64Var(&opts.Step, "step", 0.01, "Threshold step of the curve")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runEvaluate(ctx context.Context, opts *evaluateOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.Truth == "" || opts.Result == "" {
+		return errors.New("--truth and --result are required")
+	}
+	if opts.Step <= 0 || opts.Step > 1 {
+		return errors.New("--step must be in (0, 1]")
+	}
+	fsys := orOSFS(opts.FS)
+	if opts.Curve != "" {
+		if err := checkOutputs(fsys, []string{opts.Curve}, opts.Force); err != nil {
+			return err
+		}
+	}
+
+	phyla := map[string]string{}
+	if opts.Meta != "" {
+		f, err := fsys.Open(opts.Meta)
+		if err != nil {
+			return err
+		}
+		phyla, err = readMetadata(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	f, err := fsys.Open(opts.Truth)
+	if err != nil {
+		return err
+	}
+	truth, order, err := readTruth(f, phyla)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %v", opts.Truth, err)
+	}
+
+	f, err = fsys.Open(opts.Result)
+	if err != nil {
+		return err
+	}
+	records, err := readResults(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %v", opts.Result, err)
+	}
+	predictions := groupPredictions(records, phyla)
+
+	var unknown int
+	for contig := range predictions {
+		if _, ok := truth[contig]; !ok {
+			unknown++
+		}
+	}
+	if unknown > 0 {
+		fmt.Fprintf(stderr, "Warning: %d contigs of the result are not in the truth and were ignored\n", unknown)
+	}
+
+	levels := evaluationLevels
+	var positives, withPhylum int
+	for _, label := range truth {
+		if label.AccID != "" {
+			positives++
+		}
+		if label.Phylum != "" {
+			withPhylum++
+		}
+	}
+	if withPhylum == 0 {
+		fmt.Fprintln(stderr, "Warning: no phyla in the truth, give them in a third column or with --meta; phylum level skipped")
+		levels = levels[:1]
+	}
+
+	steps := int(math.Round(1 / opts.Step))
+	var curve []confusion
+	for _, level := range levels {
+		for i := 0; i <= steps; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			threshold := float32(i) * float32(opts.Step)
+			curve = append(curve, evaluate(level.name, threshold, order, truth, predictions, level.truth, level.called))
+		}
+	}
+
+	fmt.Fprintf(stdout, "# truth: %d contigs (%d from plasmids), result: %d contigs\n", len(truth), positives, len(predictions))
+
+	tw := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "LEVEL\tTHRESHOLD\tTP\tFP\tFN\tTN\tPRECISION\tRECALL\tF1\tFPR")
+	threshold := float32(opts.Threshold) * 0.01
+	for _, level := range levels {
+		at := evaluate(level.name, threshold, order, truth, predictions, level.truth, level.called)
+		best := at
+		for _, c := range curve {
+			if c.Level == level.name && c.f1() > best.f1() {
+				best = c
+			}
+		}
+		printConfusion(tw, at, "")
+		printConfusion(tw, best, "best F1")
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if opts.Curve == "" {
+		return nil
+	}
+	w, err := createOutput(fsys, opts.Curve, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := writeCurve(w, curve); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func (c confusion) precision() float64 { return ratio(c.TP, c.TP+c.FP) }
+func (c confusion) recall() float64    { return ratio(c.TP, c.TP+c.FN) }
+func (c confusion) fpr() float64       { return ratio(c.negFP, c.negFP+c.TN) }
+
+func (c confusion) f1() float64 {
+	return ratio(2*c.TP, 2*c.TP+c.FP+c.FN)
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
+
+func evaluate(level string, threshold float32, order []string, truth map[string]truthLabel, predictions map[string]prediction, truthOf func(truthLabel) string, calledOf func(prediction) []string) confusion {
+	c := confusion{Level: level, Threshold: threshold}
+	for _, contig := range order {
+		label := truth[contig]
+		positive := label.AccID != ""
+
+		p, ok := predictions[contig]
+		called := ok && p.similarity >= threshold && len(calledOf(p)) > 0
+		switch {
+		case called && positive && containsString(calledOf(p), truthOf(label)):
+			c.TP++
+		case called && positive:
+			c.FP++
+			c.FN++
+		case called:
+			c.FP++
+			c.negFP++
+		case positive:
+			c.FN++
+		default:
+			c.TN++
+		}
+	}
+	return c
+}
+
+func printConfusion(w io.Writer, c confusion, note string) {
+	fmt.Fprintf(w, "%s\t%.2f\t%d\t%d\t%d\t%d\t%.4f\t%.4f\t%.4f\t%.4f",
+		c.Level, c.Threshold, c.TP, c.FP, c.FN, c.TN, c.precision(), c.recall(), c.f1(), c.fpr())
+	if note != "" {
+		fmt.Fprintf(w, "\t%s", note)
+	}
+	fmt.Fprintln(w)
+}
+
+func writeCurve(w io.Writer, curve []confusion) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = '\t'
+	cw.Write([]string{"level", "threshold", "tp", "fp", "fn", "tn", "precision", "recall", "f1", "fpr"})
+	for _, c := range curve {
+		cw.Write([]string{
+			c.Level,
+			strconv.FormatFloat(float64(c.Threshold), 'f', -1, 32),
+			strconv.Itoa(c.TP),
+			strconv.Itoa(c.FP),
+			strconv.Itoa(c.FN),
+			strconv.Itoa(c.TN),
+			strconv.FormatFloat(c.precision(), 'f', 6, 64),
+			strconv.FormatFloat(c.recall(), 'f', 6, 64),
+			strconv.FormatFloat(c.f1(), 'f', 6, 64),
+			strconv.FormatFloat(c.fpr(), 'f', 6, 64),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// groupPredictions merges the tied hits of each contig. Hits without a phylum
+// take it from the metadata.
+func groupPredictions(records []hitRecord, phyla map[string]string) map[string]prediction {
+	predictions := map[string]prediction{}
+	for _, record := range records {
+		p := predictions[record.ContigID]
+		p.similarity = record.Similarity
+		// Contigs labelled chromosome are not plasmid calls.
+		if record.HitAccID != "" && record.Class != classChromosome {
+			p.accessions = append(p.accessions, record.HitAccID)
+			phylum := record.HitPhylum
+			if phylum == "" || phylum == "---" {
+				phylum = phyla[record.HitAccID]
+			}
+			if phylum != "" && !containsString(p.phyla, phylum) {
+				p.phyla = append(p.phyla, phylum)
+			}
+		}
+		predictions[record.ContigID] = p
+	}
+	return predictions
+}
+
+// readTruth reads true labels either from a table of contig, accession and
+// optional phylum columns, or from the headers of a FASTA file written by
+// simulate. A header row of the table, recognised by its column names, may
+// give the columns in any order. An empty accession or "-" marks a contig not from a plasmid, as
+// does a FASTA header without source=. The contigs are also returned in file
+// order.
+func readTruth(r io.Reader, phyla map[string]string) (map[string]truthLabel, []string, error) {
+	truth := map[string]truthLabel{}
+	var order []string
+	add := func(contig, acc, phylum string) {
+		if acc == "-" {
+			acc = ""
+		}
+		if phylum == "" && acc != "" {
+			phylum = phyla[acc]
+		}
+		if _, ok := truth[contig]; !ok {
+			order = append(order, contig)
+		}
+		truth[contig] = truthLabel{AccID: acc, Phylum: phylum}
+	}
+
+	br := bufio.NewReader(r)
+	if b, err := br.Peek(1); err == nil && b[0] == '>' {
+		scanner := bufio.NewScanner(br)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, ">") {
+				continue
+			}
+			fields := strings.Fields(line[1:])
+			if len(fields) == 0 {
+				continue
+			}
+			var acc string
+			for _, field := range fields[1:] {
+				if strings.HasPrefix(field, "source=") {
+					acc = strings.TrimPrefix(field, "source=")
+				}
+			}
+			add(fields[0], acc, "")
+		}
+		return truth, order, scanner.Err()
+	}
+
+	cr := csv.NewReader(br)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	if first, _ := br.Peek(4096); strings.Contains(strings.SplitN(string(first), "\n", 2)[0], "\t") {
+		cr.Comma = '\t'
+	}
+	columns := []int{0, 1, 2}
+	for line := 0; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if line == 0 {
+			if header, ok := truthHeader(record); ok {
+				columns = header
+				continue
+			}
+		}
+		field := func(i int) string {
+			if columns[i] >= 0 && columns[i] < len(record) {
+				return record[columns[i]]
+			}
+			return ""
+		}
+		if len(record) <= columns[0] || len(record) <= columns[1] {
+			return nil, nil, fmt.Errorf("line %d: want contig and accession columns", line+1)
+		}
+		add(field(0), field(1), field(2))
+	}
+	return truth, order, nil
+}
+
+// truthColumns are the names of the contig, accession and phylum columns of
+// truth tables, including those of result tables.
+var truthColumns = [][]string{
+	{"contig_id", "contig", "contigid", "query"},
+	{"accession", "acc_id", "accid", "hit_accession", "source", "besthit"},
+	{"phylum", "hit_phylum"},
+}
+
+// truthHeader returns the indices of the truth columns if record is a header
+// naming at least the contig and accession columns; a missing phylum column
+// is -1.
+func truthHeader(record []string) ([]int, bool) {
+	columns := []int{-1, -1, -1}
+	for i, name := range record {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for c, names := range truthColumns {
+			if columns[c] < 0 && containsString(names, name) {
+				columns[c] = i
+			}
+		}
+	}
+	return columns, columns[0] >= 0 && columns[1] >= 0
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const evaluateTruth = `contig_id	accession	phylum
+c1	P1	Proteobacteria
+c2	P2	Firmicutes
+c3	P3	Proteobacteria
+c4	-
+c5	-
+`
+
+const evaluateResult = `# pHash v0.2
+contig_id	contig_length	query_kmers	hit_accession	hit_phylum	similarity	shared_hashes	sketch_size	pass_threshold
+c1	1000	985	P1	Proteobacteria	0.900000	461	512	true
+c2	1000	985	P9	Firmicutes	0.500000	256	512	true
+c3	1000	985	P3	Proteobacteria	0.050000	26	512	false
+c4	1000	985	P1	Proteobacteria	0.300000	154	512	true
+c5	1000	985			0.000000	0	512	false
+`
+
+func TestEvaluate(t *testing.T) {
+	truth, order, err := readTruth(strings.NewReader(evaluateTruth), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := readResults(strings.NewReader(evaluateResult))
+	if err != nil {
+		t.Fatal(err)
+	}
+	predictions := groupPredictions(records, nil)
+
+	tests := []struct {
+		level     int
+		threshold float32
+		want      confusion
+	}{
+		// c2 is assigned to the wrong plasmid, c3 is below the threshold and
+		// c4 is a false call on a contig not from a plasmid.
+		{0, 0.1, confusion{TP: 1, FP: 2, FN: 2, TN: 1, negFP: 1}},
+		// c2 has the right phylum.
+		{1, 0.1, confusion{TP: 2, FP: 1, FN: 1, TN: 1, negFP: 1}},
+		{0, 0.4, confusion{TP: 1, FP: 1, FN: 2, TN: 2}},
+		{0, 0, confusion{TP: 2, FP: 2, FN: 1, TN: 1, negFP: 1}},
+		{0, 0.95, confusion{FN: 3, TN: 2}},
+	}
+	for _, tt := range tests {
+		level := evaluationLevels[tt.level]
+		got := evaluate(level.name, tt.threshold, order, truth, predictions, level.truth, level.called)
+		tt.want.Level, tt.want.Threshold = level.name, tt.threshold
+		if got != tt.want {
+			t.Errorf("%s at %.2f: got %+v, want %+v", level.name, tt.threshold, got, tt.want)
+		}
+	}
+
+	c := confusion{TP: 1, FP: 2, FN: 2, TN: 1, negFP: 1}
+	if c.precision() != 1.0/3 || c.recall() != 1.0/3 || c.f1() != 1.0/3 || c.fpr() != 0.5 {
+		t.Errorf("got precision %f, recall %f, F1 %f, FPR %f", c.precision(), c.recall(), c.f1(), c.fpr())
+	}
+}
+
+func TestEvaluateCommand(t *testing.T) {
+	fsys := newMemFS()
+	fsys.write("truth.tsv", evaluateTruth)
+	fsys.write("pHash.tsv", evaluateResult+"unknown\t10\t0\tP1\t\t0.5\t1\t512\ttrue\n")
+
+	var stdout, stderr bytes.Buffer
+	opts := &evaluateOptions{Truth: "truth.tsv", Result: "pHash.tsv", Curve: "curve.tsv", Threshold: 10, Step: 0.05, FS: fsys}
+	if err := runEvaluate(context.Background(), opts, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Warning: 1 contigs of the result are not in the truth and were ignored\n"; stderr.String() != want {
+		t.Errorf("warnings %q, want %q", stderr.String(), want)
+	}
+	want := `# truth: 5 contigs (3 from plasmids), result: 6 contigs
+LEVEL      THRESHOLD  TP  FP  FN  TN  PRECISION  RECALL  F1      FPR
+accession  0.10       1   2   2   1   0.3333     0.3333  0.3333  0.5000
+accession  0.00       2   2   1   1   0.5000     0.6667  0.5714  0.5000  best F1
+phylum     0.10       2   1   1   1   0.6667     0.6667  0.6667  0.5000
+phylum     0.00       3   1   0   1   0.7500     1.0000  0.8571  0.5000  best F1
+`
+	if stdout.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", stdout.String(), want)
+	}
+
+	curve := strings.Split(strings.TrimSpace(fsys.read(t, "curve.tsv")), "\n")
+	if len(curve) != 1+2*21 {
+		t.Fatalf("curve has %d lines, want a header and 21 thresholds per level", len(curve))
+	}
+	if want := "phylum\t0.1\t2\t1\t1\t1\t0.666667\t0.666667\t0.666667\t0.500000"; curve[21+3] != want {
+		t.Errorf("curve line %q, want %q", curve[21+3], want)
+	}
+}
+
+func TestReadResultsLegacy(t *testing.T) {
+	table := `,ContigID,BestHit,Similarity
+0,scaffold1,NZ_CP029395.1,(0.869806)
+1,scaffold39,NC_022077.1:NC_025117.1,(0.739583)
+`
+	records, err := readResults(strings.NewReader(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []hitRecord{
+		{ContigID: "scaffold1", HitAccID: "NZ_CP029395.1", Similarity: 0.869806},
+		{ContigID: "scaffold39", HitAccID: "NC_022077.1", Similarity: 0.739583},
+		{ContigID: "scaffold39", HitAccID: "NC_025117.1", Similarity: 0.739583},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("got %+v, want %+v", records, want)
+	}
+}
+
+func TestReadResultsFormats(t *testing.T) {
+	records := []hitRecord{
+		{ContigID: "c1", ContigLength: 1000, QueryKmers: 985, HitAccID: "P1", HitPhylum: "Proteobacteria", Similarity: 0.5, SharedHashes: 256, SketchSize: 512, PassThreshold: true},
+		{ContigID: "c2", ContigLength: 20, QueryKmers: 5, SketchSize: 512},
+	}
+
+	for _, format := range resultFormats {
+		var buf bytes.Buffer
+		rw, err := newResultWriter(&buf, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rw.WriteHeader(runInfo{Version: version})
+		for _, record := range records {
+			rw.Write(record)
+		}
+		rw.Flush()
+
+		got, err := readResults(&buf)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if !reflect.DeepEqual(got, records) {
+			t.Errorf("%s: got %+v, want %+v", format, got, records)
+		}
+	}
+}
+
+func TestReadTruthFasta(t *testing.T) {
+	fasta := ">pA_0 source=pA start=0 length=4 errors=0\nACGT\n>chr_1\nACGT\n"
+	truth, order, err := readTruth(strings.NewReader(fasta), map[string]string{"pA": "Firmicutes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]truthLabel{"pA_0": {AccID: "pA", Phylum: "Firmicutes"}, "chr_1": {}}
+	if !reflect.DeepEqual(truth, want) || !reflect.DeepEqual(order, []string{"pA_0", "chr_1"}) {
+		t.Errorf("got %v %v, want %v", truth, order, want)
+	}
+}
+
+func TestReadTruthHeader(t *testing.T) {
+	want := map[string]truthLabel{"c1": {AccID: "P1", Phylum: "Firmicutes"}, "c2": {}}
+	tests := []struct {
+		name  string
+		table string
+	}{
+		{"no header", "c1\tP1\tFirmicutes\nc2\t-\n"},
+		{"contig_id", "contig_id\taccession\tphylum\nc1\tP1\tFirmicutes\nc2\t-\n"},
+		{"other names", "# labels\nContig,Phylum,Accession\nc1,Firmicutes,P1\nc2,,-\n"},
+		{"result columns", "contig_id,contig_length,hit_accession,hit_phylum\nc1,1000,P1,Firmicutes\nc2,1000,,\n"},
+	}
+	for _, tt := range tests {
+		truth, order, err := readTruth(strings.NewReader(tt.table), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(truth, want) || !reflect.DeepEqual(order, []string{"c1", "c2"}) {
+			t.Errorf("%s: got %v %v, want %v", tt.name, truth, order, want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	fsys := newMemFS()
+	fsys.write("truth.tsv", evaluateTruth)
+	fsys.write("bad.tsv", "contig_id\tsimilarity\nc1\t0.5\n")
+
+	for _, opts := range []*evaluateOptions{
+		{Result: "bad.tsv", Step: 0.01},
+		{Truth: "truth.tsv", Result: "bad.tsv", Step: 0},
+		{Truth: "truth.tsv", Result: "bad.tsv", Step: 0.01},
+	} {
+		opts.FS = fsys
+		if err := runEvaluate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err == nil {
+			t.Errorf("%+v: no error", opts)
+		}
+	}
+}
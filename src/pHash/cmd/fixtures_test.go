@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// memFS is an in-memory fileSystem. Files become visible when they are closed,
+// except that CreateNew makes an empty file at once, as the OS does.
+type memFS struct {
+	files map[string][]byte
+}
+
+func newMemFS() *memFS {
+	return &memFS{files: map[string][]byte{}}
+}
+
+func (m *memFS) Open(name string) (io.ReadCloser, error) {
+	b, ok := m.files[filepath.Clean(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (m *memFS) Create(name string) (io.WriteCloser, error) {
+	return &memFile{fs: m, name: filepath.Clean(name)}, nil
+}
+
+func (m *memFS) CreateNew(name string) (io.WriteCloser, error) {
+	if _, ok := m.files[filepath.Clean(name)]; ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrExist}
+	}
+	m.files[filepath.Clean(name)] = nil
+	return m.Create(name)
+}
+
+func (m *memFS) Stat(name string) (os.FileInfo, error) {
+	b, ok := m.files[filepath.Clean(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+	return memFileInfo{name: filepath.Base(name), size: int64(len(b))}, nil
+}
+
+func (m *memFS) ReadDir(name string) ([]os.FileInfo, error) {
+	dir := filepath.Clean(name)
+	var infos []os.FileInfo
+	for path, b := range m.files {
+		if filepath.Dir(path) == dir {
+			infos = append(infos, memFileInfo{name: filepath.Base(path), size: int64(len(b))})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	return infos, nil
+}
+
+func (m *memFS) MkdirAll(path string, perm os.FileMode) error {
+	return nil
+}
+
+func (m *memFS) write(name string, contents string) {
+	m.files[filepath.Clean(name)] = []byte(contents)
+}
+
+func (m *memFS) read(t *testing.T, name string) string {
+	t.Helper()
+	b, ok := m.files[filepath.Clean(name)]
+	if !ok {
+		t.Fatalf("%s was not written", name)
+	}
+	return string(b)
+}
+
+// results reads a result file written by identify into its records by
+// contig; a contig with tied hits keeps the record of its first hit.
+func (m *memFS) results(t *testing.T, name string) map[string]hitRecord {
+	t.Helper()
+	records, err := readResults(strings.NewReader(m.read(t, name)))
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	byContig := map[string]hitRecord{}
+	for _, record := range records {
+		if _, ok := byContig[record.ContigID]; !ok {
+			byContig[record.ContigID] = record
+		}
+	}
+	return byContig
+}
+
+type memFile struct {
+	bytes.Buffer
+	fs     *memFS
+	name   string
+	closed bool
+}
+
+func (f *memFile) Close() error {
+	if !f.closed {
+		f.closed = true
+		f.fs.files[f.name] = f.Bytes()
+	}
+	return nil
+}
+
+type memFileInfo struct {
+	name string
+	size int64
+}
+
+func (fi memFileInfo) Name() string       { return fi.name }
+func (fi memFileInfo) Size() int64        { return fi.size }
+func (fi memFileInfo) Mode() os.FileMode  { return 0666 }
+func (fi memFileInfo) ModTime() time.Time { return time.Date(2018, 6, 11, 0, 0, 0, 0, time.UTC) }
+func (fi memFileInfo) IsDir() bool        { return false }
+func (fi memFileInfo) Sys() interface{}   { return nil }
+
+// randomSequence returns n random bases.
+func randomSequence(rng *rand.Rand, n int) string {
+	const bases = "ACGT"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = bases[rng.Intn(len(bases))]
+	}
+	return string(b)
+}
+
+// mutate substitutes each base of s with a different one with probability rate.
+func mutate(rng *rand.Rand, s string, rate float64) string {
+	const bases = "ACGT"
+	b := []byte(s)
+	for i := range b {
+		if rng.Float64() < rate {
+			c := b[i]
+			for c == b[i] {
+				c = bases[rng.Intn(len(bases))]
+			}
+			b[i] = c
+		}
+	}
+	return string(b)
+}
+
+func reverseComplement(s string) string {
+	return ambiguousDnaComplement.Replace(rev(&s))
+}
+
+// fastaRecord is a sequence of a synthetic FASTA file.
+type fastaRecord struct {
+	id   string
+	desc string
+	seq  string
+}
+
+func formatFasta(records []fastaRecord) string {
+	var b strings.Builder
+	for _, r := range records {
+		if r.desc != "" {
+			fmt.Fprintf(&b, ">%s %s\n", r.id, r.desc)
+		} else {
+			fmt.Fprintf(&b, ">%s\n", r.id)
+		}
+		for i := 0; i < len(r.seq); i += 70 {
+			end := i + 70
+			if end > len(r.seq) {
+				end = len(r.seq)
+			}
+			fmt.Fprintln(&b, r.seq[i:end])
+		}
+	}
+	return b.String()
+}
+
+// checkGolden compares got with testdata/golden/name, or rewrites the file
+// when the tests run with -update.
+func checkGolden(t *testing.T, name string, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from golden file %s:\n%s", name, path, got)
+	}
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/OneOfOne/xxhash"
+	"github.com/spaolacci/murmur3"
+)
+
+// Hasher hashes the k-mers of sketches. Canonical picks the strand of a
+// k-mer that is hashed, and Hash is called with the seeds 0 to sketch size -
+// 1, one for each value of a k-mins sketch.
+type Hasher interface {
+	Name() string
+	Canonical(kmer string, reverseComplement string) string
+	Hash(kmer []byte, seed uint64) uint64
+}
+
+type (
+	// xxhash64Hasher is the hasher of pHash from the start. The strand is
+	// picked by the higher 32-bit xxhash.
+	xxhash64Hasher struct{}
+
+	// murmur3Hasher takes the first 64 bits of MurmurHash3 x64 128 of the
+	// lexicographically smaller strand. Only the hash function is that of
+	// Mash and sourmash; the seeds and the k-mins sketch are those of pHash.
+	murmur3Hasher struct{}
+
+	// fnv1aHasher is 64-bit FNV-1a of the seed, in little-endian, followed
+	// by the k-mer, with the lexicographically smaller strand.
+	fnv1aHasher struct{}
+)
+
+// defaultHasher hashes databases without a hash function in their header.
+var defaultHasher Hasher = xxhash64Hasher{}
+
+var hashers = []Hasher{xxhash64Hasher{}, murmur3Hasher{}, fnv1aHasher{}}
+
+func (xxhash64Hasher) Name() string { return "xxhash64" }
+
+func (xxhash64Hasher) Canonical(kmer string, reverseComplement string) string {
+	if xxhash.ChecksumString32S(kmer, 0) > xxhash.ChecksumString32S(reverseComplement, 0) {
+		return kmer
+	}
+	return reverseComplement
+}
+
+func (xxhash64Hasher) Hash(kmer []byte, seed uint64) uint64 {
+	return xxhash.Checksum64S(kmer, seed)
+}
+
+func (murmur3Hasher) Name() string { return "murmur3" }
+
+func (murmur3Hasher) Canonical(kmer string, reverseComplement string) string {
+	return lexicographicCanonical(kmer, reverseComplement)
+}
+
+func (murmur3Hasher) Hash(kmer []byte, seed uint64) uint64 {
+	h1, _ := murmur3.Sum128WithSeed(kmer, uint32(seed))
+	return h1
+}
+
+func (fnv1aHasher) Name() string { return "fnv1a" }
+
+func (fnv1aHasher) Canonical(kmer string, reverseComplement string) string {
+	return lexicographicCanonical(kmer, reverseComplement)
+}
+
+func (fnv1aHasher) Hash(kmer []byte, seed uint64) uint64 {
+	var prefix [8]byte
+	binary.LittleEndian.PutUint64(prefix[:], seed)
+	h := fnv.New64a()
+	h.Write(prefix[:])
+	h.Write(kmer)
+	return h.Sum64()
+}
+
+func lexicographicCanonical(kmer string, reverseComplement string) string {
+	if reverseComplement < kmer {
+		return reverseComplement
+	}
+	return kmer
+}
+
+// hasherNames lists the names of the hashers.
+func hasherNames() []string {
+	var names []string
+	for _, h := range hashers {
+		names = append(names, h.Name())
+	}
+	return names
+}
+
+// lookupHasher returns the hasher of a name in a database header, where the
+// empty name of older databases is xxhash64.
+func lookupHasher(name string) (Hasher, error) {
+	if name == "" {
+		return defaultHasher, nil
+	}
+	for _, h := range hashers {
+		if h.Name() == name {
+			return h, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown hash function %s (choose from %s)", name, strings.Join(hasherNames(), ", "))
+}
+
+// hashName is the name of the hasher of a database header.
+func hashName(header string) string {
+	if header == "" {
+		return defaultHasher.Name()
+	}
+	return header
+}
+
+// hashHeader is the name of a hasher as written in a database header, empty
+// for xxhash64 so that those databases are encoded as before.
+func hashHeader(h Hasher) string {
+	if h.Name() == defaultHasher.Name() {
+		return ""
+	}
+	return h.Name()
+}
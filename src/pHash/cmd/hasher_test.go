@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHashersStrandIndependent(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	s := randomSequence(rng, 2000)
+	for _, hasher := range hashers {
+		forward, n := calcMinHash(letters(s), 16, 64, hasher, nil)
+		reverse, m := calcMinHash(letters(reverseComplement(s)), 16, 64, hasher, nil)
+		if n != m || !reflect.DeepEqual(forward, reverse) {
+			t.Errorf("%s sketches differ between strands", hasher.Name())
+		}
+	}
+
+	a, _ := calcMinHash(letters(s), 16, 64, murmur3Hasher{}, nil)
+	b, _ := calcMinHash(letters(s), 16, 64, fnv1aHasher{}, nil)
+	if reflect.DeepEqual(a, b) {
+		t.Error("murmur3 and fnv1a give the same sketch")
+	}
+}
+
+func TestLookupHasher(t *testing.T) {
+	tests := []struct {
+		name string
+		want Hasher
+		err  string
+	}{
+		{name: "", want: xxhash64Hasher{}},
+		{name: "xxhash64", want: xxhash64Hasher{}},
+		{name: "murmur3", want: murmur3Hasher{}},
+		{name: "fnv1a", want: fnv1aHasher{}},
+		{name: "md5", err: "unknown hash function md5 (choose from xxhash64, murmur3, fnv1a)"},
+	}
+	for _, tt := range tests {
+		h, err := lookupHasher(tt.name)
+		if h != tt.want || (err == nil) != (tt.err == "") || (err != nil && err.Error() != tt.err) {
+			t.Errorf("lookupHasher(%q) = %v, %v, want %v, %q", tt.name, h, err, tt.want, tt.err)
+		}
+	}
+	if hashHeader(xxhash64Hasher{}) != "" || hashHeader(murmur3Hasher{}) != "murmur3" {
+		t.Error("only xxhash64 should leave the header empty")
+	}
+}
+
+func TestIdentifyMurmur3(t *testing.T) {
+	fsys := newSyntheticFS()
+	opts := &makedbOptions{In: "refs.fna", Metadata: testRefPhylum, Out: "murmur3.phash", Kmer: 16, Sketch: 128, Hash: "murmur3", FS: fsys}
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+	binary := []byte(fsys.read(t, "murmur3.phash"))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil || plasmids.Hash != "murmur3" {
+		t.Fatalf("database hash %q, %v, want murmur3", plasmids.Hash, err)
+	}
+
+	identify := defaultIdentifyOptions(fsys)
+	identify.DB = "murmur3.phash"
+	if err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+	header := strings.SplitN(fsys.read(t, "out/pHash.tsv"), "\n", 3)[1]
+	if want := "# database: murmur3.phash (k=16, sketch=128, plasmids=4, hash=murmur3)"; header != want {
+		t.Errorf("result header %q, want %q", header, want)
+	}
+	results := fsys.results(t, "out/pHash.tsv")
+	exact := results["exact"]
+	if exact.ContigLength != 4000 || exact.HitAccID != "NZ_SYN0001.1" || exact.HitPhylum != "Proteobacteria" || exact.Similarity != 1 || exact.SharedHashes != 128 || !exact.PassThreshold {
+		t.Errorf("exact copy identified with murmur3 as %+v", exact)
+	}
+	if unrelated := results["unrelated"]; unrelated.HitAccID != "" || unrelated.PassThreshold {
+		t.Errorf("unrelated contig identified with murmur3 as %+v", unrelated)
+	}
+
+	makeSyntheticDB(t, fsys)
+	sketch := &sketchOptions{In: testQueries, Out: "queries.phs", DB: testDB, FS: fsys}
+	if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+	identify = defaultIdentifyOptions(fsys)
+	identify.DB = "murmur3.phash"
+	identify.In = "queries.phs"
+	identify.Force = true
+	err = runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "queries.phs was sketched with xxhash64, the database with murmur3" {
+		t.Errorf("got error %v for sketches of another hash function", err)
+	}
+
+	dist := &distOptions{DB: testDB, DB2: "murmur3.phash", Format: "long", Metric: "jaccard", Out: "dist.tsv", FS: fsys}
+	err = runDist(context.Background(), dist, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "databases differ in hash function (xxhash64 and murmur3)" {
+		t.Errorf("got error %v for databases of different hash functions", err)
+	}
+}
@@ -1,333 +1,703 @@
 package cmd
 
 import (
-	"bytes"
+	"context"
+	"errors"
 	"fmt"
-	"html/template"
 	"io"
-	"io/ioutil"
-	"log"
 	"math"
-	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/OneOfOne/xxhash"
 	"github.com/biogo/biogo/alphabet"
 	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
 	"github.com/biogo/biogo/seq/linear"
 	"github.com/spf13/cobra"
 )
 
+type identifyOptions struct {
+	In         string
+	DB         string
+	DataDir    string
+	Threshold  int
+	Format     string
+	OutDir     string
+	Prefix     string
+	Force      bool
+	Template   string
+	Link       string
+	Window     int
+	Step       int
+	Coverage   bool
+	Margin     int
+	Replicons  string
+	Resistance string
+	MarkerCov  int
+	MarkerID   int
+	MinLength  int
+	MinKmers   int
+	FS         fileSystem
+}
+
 func init() {
-	RootCmd.AddCommand(identifyCmd)
-	identifyCmd.Flags().StringVarP(&o.optIn, "in", "i", "", "Input FASTA file")
-	identifyCmd.Flags().StringVarP(&o.optDB, "db", "d", "", "Database")
-	identifyCmd.Flags().IntVarP(&o.optThreshold, "threshold", "t", 10, "Threshold of probability")
+	RootCmd.AddCommand(newIdentifyCmd())
 }
 
-var identifyCmd = &cobra.Command{
-	Use:   "identify",
-	Short: "Identifier of plasmid",
-	Long:  "Identifier of plasmid using database",
-	Run: func(cmd *cobra.Command, args []string) {
+func newIdentifyCmd() *cobra.Command {
+	opts := &identifyOptions{}
 
-		if o.optIn == "" || o.optDB == "" {
-			fmt.Println("--in and --db are required")
-			cmd.Help()
-			os.Exit(0)
-		}
+	cmd := &cobra.Command{
+		Use:   "identify",
+		Short: "Identifier of plasmid",
+		Long:  "Identifier of plasmid using database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIdentify(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
 
-		inFile := o.optIn
-		db := o.optDB
-		threshold := float32(o.optThreshold) * 0.01
-		outFile := "pHash.log.txt"
-
-		cpus := runtime.NumCPU()
-		runtime.GOMAXPROCS(cpus)
-
-		var in *fasta.Reader
-		if inFile == "" {
-			in = fasta.NewReader(os.Stdin, linear.NewSeq("", nil, alphabet.DNA))
-		} else if f, err := os.Open(inFile); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v.", err)
-			os.Exit(1)
-		} else {
-			in = fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
-			defer f.Close()
-		}
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Input FASTA file, or sketch file written by sketch")
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Database file, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().IntVarP(&opts.Threshold, "threshold", "t", 10, "Threshold of probability")
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "tsv", "Result format (tsv, csv or jsonl)")
+	cmd.Flags().StringVar(&opts.OutDir, "outdir", ".", "Output directory")
+	cmd.Flags().StringVar(&opts.Prefix, "prefix", "pHash", "Prefix of output files")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite existing output files")
+	cmd.Flags().StringVar(&opts.Template, "template", "", "Custom report template (Go html/template)")
+	cmd.Flags().StringVar(&opts.Link, "link", defaultLinkPattern, "URL pattern of hit links, {acc} is replaced by the accession")
+	cmd.Flags().IntVarP(&opts.Window, "window", "w", 0, "Window size for sub-contig scanning (0 disables)")
+	cmd.Flags().IntVar(&opts.Step, "step", 0, "Step size of sliding window (default half of --window)")
+	cmd.Flags().BoolVar(&opts.Coverage, "coverage", false, "Read contig coverage from FASTA headers and estimate copy numbers")
+	cmd.Flags().IntVar(&opts.Margin, "margin", 5, "Margin of similarity between plasmid and chromosome hits to label a contig")
+	cmd.Flags().StringVar(&opts.Replicons, "replicons", "", "Marker database of replicons built by markerdb")
+	cmd.Flags().StringVar(&opts.Resistance, "resistance", "", "Marker database of resistance genes built by markerdb")
+	cmd.Flags().IntVar(&opts.MarkerCov, "marker-coverage", 60, "Minimum coverage (%) of marker hits")
+	cmd.Flags().IntVar(&opts.MarkerID, "marker-identity", 90, "Minimum estimated identity (%) of marker hits")
+	cmd.Flags().IntVar(&opts.MinLength, "min-length", 0, "Skip contigs shorter than this")
+	cmd.Flags().IntVar(&opts.MinKmers, "min-kmers", 0, "Skip contigs with fewer distinct k-mers than this (contigs without k-mers are always skipped)")
+
+	return cmd
+}
+
+// identifier compares query contigs against a decoded database, sketching
+// them with its sketcher.
+type identifier struct {
+	sketcher
+	records     []PlasmidRecord
+	chromosomes []PlasmidRecord
+	replicons   *markerIndex
+	resistance  *markerIndex
+	threshold   float32
+	margin      float32
+	window      int
+	step        int
+	link        string
+	coverage    bool
+	minLength   int
+	minKmers    int
+}
+
+// skipSummary counts the contigs skipped for a reason and their bases.
+type skipSummary struct {
+	Reason  string `json:"reason"`
+	Contigs int    `json:"contigs"`
+	Bases   int    `json:"bases"`
+}
+
+type contigResult struct {
+	records    []hitRecord
+	similarity float32
+	row        *reportRow
+	desc       string
+	windowHits []windowHit
+	coverage   *float64
+	pass       bool
+	class      string
+	skipped    string // reason the contig was not identified
+}
+
+// query is a contig to identify, read from FASTA or from a sketch file. seq
+// is nil for sketches, which cannot be scanned by windows or for markers.
+type query struct {
+	name    string
+	desc    string
+	length  int
+	kmers   int
+	minHash []uint64
+	seq     alphabet.Slice
+}
+
+// pendingResult is a contig result waiting to be written.
+type pendingResult struct {
+	q      query
+	result contigResult
+}
+
+// newQuery sketches a FASTA contig, unless it is too short to identify.
+func (id *identifier) newQuery(s seq.Sequence) query {
+	q := query{name: s.Name(), desc: s.Description(), length: s.Len(), seq: s.Slice()}
+	if q.length >= id.minLength {
+		q.minHash, q.kmers = id.sketch(s.Slice())
+	}
+	return q
+}
+
+// sketchQuery is a contig of a sketch file.
+func sketchQuery(contig ContigSketch) query {
+	return query{name: contig.Name, desc: contig.Description, length: contig.Length, kmers: contig.Kmers, minHash: contig.MinHashValue}
+}
 
-		ambiguousDnaComplement := strings.NewReplacer(
-			"A", "T",
-			"C", "G",
-			"G", "C",
-			"T", "A",
-			"M", "K",
-			"R", "Y",
-			"Y", "R",
-			"K", "M",
-			"V", "B",
-			"H", "D",
-			"D", "H",
-			"B", "V")
-
-		type Row struct {
-			AccID   string
-			Seq     string
-			Length  int
-			Link    template.HTML
-			Phylum  string
-			Jaccard float32
+// skipReason tells why a contig is not identified, or returns "".
+func (id *identifier) skipReason(q query) string {
+	switch {
+	case q.length < id.minLength:
+		return fmt.Sprintf("shorter than %d bases", id.minLength)
+	case q.kmers == 0:
+		return "without k-mers"
+	case q.kmers < id.minKmers:
+		return fmt.Sprintf("with fewer than %d k-mers", id.minKmers)
+	}
+	return ""
+}
+
+// addSkipped counts a skipped contig in the summary of its reason.
+func addSkipped(summaries []skipSummary, reason string, length int) []skipSummary {
+	for i := range summaries {
+		if summaries[i].Reason == reason {
+			summaries[i].Contigs++
+			summaries[i].Bases += length
+			return summaries
 		}
+	}
+	return append(summaries, skipSummary{Reason: reason, Contigs: 1, Bases: length})
+}
 
-		type table []Row
-		var tb table
+// formatSkipped describes the skipped contigs in one line.
+func formatSkipped(summaries []skipSummary) string {
+	var total int
+	var reasons []string
+	for _, s := range summaries {
+		total += s.Contigs
+		reasons = append(reasons, fmt.Sprintf("%d %s (%d bases)", s.Contigs, s.Reason, s.Bases))
+	}
+	return fmt.Sprintf("Skipped %d contigs: %s", total, strings.Join(reasons, ", "))
+}
 
-		binary, err := ioutil.ReadFile(db)
-		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
+// seqSymbol abbreviates a sequence to its first and last three bases.
+func seqSymbol(read alphabet.Slice) string {
+	if read == nil {
+		return ""
+	}
+	seq := read.(alphabet.Letters).String()
+	if len(seq) <= 6 {
+		return seq
+	}
+	return fmt.Sprintf("%s...%s", seq[:3], seq[len(seq)-3:])
+}
+
+func (id *identifier) identify(q query) contigResult {
+	if reason := id.skipReason(q); reason != "" {
+		record := hitRecord{ContigID: q.name, ContigLength: q.length, QueryKmers: q.kmers, SketchSize: id.sketchSize, Skipped: reason}
+		return contigResult{records: []hitRecord{record}, skipped: reason}
+	}
+	read, minHashValues, kmerCount := q.seq, q.minHash, q.kmers
+	bestHits, bestHitValue := searchBestHits(id.scheme, minHashValues, id.records, id.sketchSize)
+
+	var result contigResult
+	if id.window > 0 && read != nil {
+		for _, w := range slidingWindows(read.Len(), id.window, id.step) {
+			windowMinHash, _ := id.sketch(read.Slice(w[0], w[1]))
+			hits, value := searchBestHits(id.scheme, windowMinHash, id.records, id.sketchSize)
+			if value < id.threshold {
+				continue
+			}
+			for _, hit := range hits {
+				result.windowHits = append(result.windowHits, windowHit{Start: w[0], End: w[1], AccID: hit.AccID, Similarity: value})
 			}
-			return
 		}
+		result.windowHits = mergeWindowHits(result.windowHits)
+	}
 
-		k := messagePackDecoding(&binary).Kmer
-		sketchSize := messagePackDecoding(&binary).SketchSize
-		plasmidsRecords := messagePackDecoding(&binary).Plasmid
+	var (
+		bestHitKeys  []string
+		hits         []reportHit
+		phyla        []string
+		sharedHashes int
+	)
+	if len(bestHits) > 0 {
+		sharedHashes, _ = id.scheme.Compare(minHashValues, bestHits[0].PlasmidMinHashValue, id.sketchSize)
+	}
+	for _, hit := range bestHits {
+		bestHitKeys = append(bestHitKeys, hit.AccID)
+		hits = append(hits, reportHit{AccID: hit.AccID, Phylum: hit.Phylum, URL: hitURL(id.link, hit.AccID)})
+		if !containsString(phyla, hit.Phylum) {
+			phyla = append(phyla, hit.Phylum)
+		}
+	}
 
-		var wg sync.WaitGroup
-		mutex := new(sync.Mutex)
+	if id.coverage {
+		if coverage, ok := parseCoverage(q.name, q.desc); ok {
+			result.coverage = &coverage
+		}
+	}
 
-		fwfasta, err := os.Create("pHash_plasmids.fna")
-		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
-			return
+	record := hitRecord{
+		ContigID:      q.name,
+		ContigLength:  q.length,
+		QueryKmers:    kmerCount,
+		Similarity:    bestHitValue,
+		SharedHashes:  sharedHashes,
+		SketchSize:    id.sketchSize,
+		PassThreshold: len(bestHits) > 0 && bestHitValue >= id.threshold,
+		Coverage:      result.coverage,
+	}
+	if len(bestHits) == 0 {
+		record.Similarity = 0
+	}
+	if len(id.chromosomes) > 0 {
+		chromosomeHits, chromosomeValue := searchBestHits(id.scheme, minHashValues, id.chromosomes, id.sketchSize)
+		if len(chromosomeHits) > 0 {
+			record.ChromosomeHit = chromosomeHits[0].AccID
+			record.ChromosomeSimilarity = chromosomeValue
+		}
+		record.Class, record.Margin = classifyContig(record.Similarity, record.ChromosomeSimilarity, id.threshold, id.margin)
+		result.class = record.Class
+	}
+	identified := record.PassThreshold && result.class != classChromosome
+	if identified && id.replicons != nil && read != nil {
+		record.Replicons = id.replicons.scan(read)
+	}
+	if identified && id.resistance != nil && read != nil {
+		record.Resistance = id.resistance.scan(read)
+	}
+	if len(bestHits) == 0 {
+		result.records = append(result.records, record)
+	}
+	for _, hit := range bestHits {
+		record.HitAccID = hit.AccID
+		record.HitPhylum = hit.Phylum
+		result.records = append(result.records, record)
+	}
+	result.similarity = record.Similarity
+	result.pass = record.PassThreshold
+
+	if identified {
+		result.row = &reportRow{AccID: q.name, Seq: seqSymbol(read), Length: q.length, Hits: hits, Phylum: strings.Join(phyla, ", "), Jaccard: bestHitValue, Class: result.class, Replicons: record.Replicons, Resistance: record.Resistance}
+		if result.coverage != nil {
+			result.row.Coverage = *result.coverage
+		}
+		result.desc = fmt.Sprintf("Similar to %s (%f)", strings.Join(bestHitKeys, ":"), bestHitValue)
+		if len(record.Replicons) > 0 {
+			result.desc += " replicons=" + strings.Join(markerGroups(record.Replicons), ",")
 		}
-		fastaw := fasta.NewWriter(fwfasta, 60)
-		plasmidSeq := linear.NewSeq("", nil, alphabet.DNA)
+		if len(record.Resistance) > 0 {
+			result.desc += " resistance=" + strings.Join(markerGroups(record.Resistance), ",")
+		}
+	}
+
+	return result
+}
+
+func runIdentify(ctx context.Context, opts *identifyOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.DB == "" {
+		return errors.New("--in and --db are required")
+	}
+	fsys := orOSFS(opts.FS)
+
+	inFile := opts.In
+	db, err := resolveDatabase(fsys, opts.DB, opts.DataDir)
+	if err != nil {
+		return err
+	}
+	threshold := float32(opts.Threshold) * 0.01
+	if opts.MinLength < 0 || opts.MinKmers < 0 {
+		return errors.New("--min-length and --min-kmers must not be negative")
+	}
+	format := opts.Format
+	if !containsString(resultFormats, format) {
+		return fmt.Errorf("--format must be one of %s", strings.Join(resultFormats, ", "))
+	}
+	outFile := filepath.Join(opts.OutDir, opts.Prefix+"."+format)
+	fastaFile := filepath.Join(opts.OutDir, opts.Prefix+"_plasmids.fna")
+	bedFile := filepath.Join(opts.OutDir, opts.Prefix+".bed")
+	reportFile := filepath.Join(opts.OutDir, opts.Prefix+"_report.html")
+	window := opts.Window
+	step := opts.Step
+	if step <= 0 {
+		step = window / 2
+	}
+
+	// Sketch files have no sequences to write, scan by windows or search
+	// for markers.
+	sketchInput, err := isSketchFile(fsys, inFile)
+	if err != nil {
+		return err
+	}
+	if sketchInput && (window > 0 || opts.Replicons != "" || opts.Resistance != "") {
+		return errors.New("--window, --replicons and --resistance need a FASTA input, not a sketch file")
+	}
+
+	outputs := []string{outFile, reportFile}
+	if !sketchInput {
+		outputs = append(outputs, fastaFile)
+	}
+	if window > 0 {
+		outputs = append(outputs, bedFile)
+	}
+	if err := checkOutputs(fsys, outputs, opts.Force); err != nil {
+		return err
+	}
+	reportTemplate, err := loadReportTemplate(fsys, opts.Template)
+	if err != nil {
+		return err
+	}
+
+	binary, err := readFile(fsys, db)
+	if err != nil {
+		return err
+	}
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		return err
+	}
+
+	k := plasmids.Kmer
+	if window > 0 && (window < k || step < 1) {
+		return fmt.Errorf("--window must be at least the k-mer length (%d)", k)
+	}
+	sketchSize := plasmids.SketchSize
+	sk, err := plasmids.sketcher()
+	if err != nil {
+		return err
+	}
 
-		fw, err := os.Create(outFile)
+	id := &identifier{
+		sketcher:    sk,
+		records:     plasmids.Plasmid,
+		chromosomes: plasmids.Chromosome,
+		threshold:   threshold,
+		margin:      float32(opts.Margin) * 0.01,
+		window:      window,
+		step:        step,
+		link:        opts.Link,
+		coverage:    opts.Coverage,
+		minLength:   opts.MinLength,
+		minKmers:    opts.MinKmers,
+	}
+
+	run := runInfo{
+		Version:    version,
+		Database:   db,
+		Kmer:       k,
+		SketchSize: sketchSize,
+		Plasmids:   len(plasmids.Plasmid),
+		Hash:       plasmids.Hash,
+		Scheme:     plasmids.Scheme,
+		Masked:     len(plasmids.Mask),
+		Threshold:  float64(opts.Threshold) / 100,
+		Window:     window,
+		Step:       step,
+		MinLength:  opts.MinLength,
+		MinKmers:   opts.MinKmers,
+	}
+	if len(plasmids.Chromosome) > 0 {
+		run.Chromosomes = len(plasmids.Chromosome)
+		run.Margin = float64(opts.Margin) / 100
+	}
+	markerCoverage, markerIdentity := float64(opts.MarkerCov)/100, float64(opts.MarkerID)/100
+	if opts.Replicons != "" {
+		index, info, err := loadMarkers(fsys, opts.Replicons, markerCoverage, markerIdentity)
 		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
-			return
+			return err
 		}
-		defer fw.Close()
-
-		line := "AccId\tSimilarPlasmidAccId\tSimilarity\n"
-		fw.Write(([]byte)(line))
-
-		for i := 0; i < 1000; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				for {
-					mutex.Lock()
-					s, err := in.Read()
-					mutex.Unlock()
-
-					if err != nil {
-						if err != io.EOF {
-							fmt.Println(err)
-							os.Exit(1)
-						}
-						break
-					}
-
-					read := s.Slice()
-					kmerNum := read.Len() - (k - 1)
-					kmerMap := make(map[string]struct{}, kmerNum)
-					var seq string
-
-					for i := 0; i < kmerNum; i++ {
-						seq = read.Slice(i, i+k).(alphabet.Letters).String()
-						if strings.Index(seq, "N") == -1 {
-							reverseComplement := ambiguousDnaComplement.Replace(rev(&seq))
-
-							h1 := xxhash.ChecksumString32S(seq, 0)
-							h2 := xxhash.ChecksumString32S(reverseComplement, 0)
-
-							var canonicalKmer string
-							if h1 > h2 {
-								canonicalKmer = seq
-							} else {
-								canonicalKmer = reverseComplement
-							}
-							kmerMap[canonicalKmer] = struct{}{}
-						}
-					}
-
-					kmerList := make([][]byte, len(kmerMap))
-					for key := range kmerMap {
-						kmerList = append(kmerList, []byte(key))
-					}
-
-					minHashValues := make([]*uint64, sketchSize)
-
-					for i := uint64(0); i < sketchSize; i++ {
-						minValue := uint64((1 << 64) - 1)
-						for _, kmer := range kmerList {
-							xxhv := xxhash.Checksum64S(kmer, i)
-							if minValue > xxhv {
-								minValue = xxhv
-							}
-						}
-						minHashValues[i] = &minValue
-					}
-
-					var (
-						bestHitKeys  []string
-						bestHitValue float32
-						similarity   float32
-					)
-
-					for _, record := range plasmidsRecords {
-						refKey := record.AccID
-						refValue := record.PlasmidMinHashValue
-						similarity = calcSimilarity(minHashValues, &refValue, sketchSize)
-
-						if similarity > bestHitValue {
-							bestHitKeys = []string{refKey}
-							bestHitValue = similarity
-						} else if similarity == bestHitValue {
-							bestHitKeys = append(bestHitKeys, refKey)
-						}
-					}
-
-					mutex.Lock()
-					seqSymbol := fmt.Sprintf("%s...%s", seq[:3], seq[len(seq)-3:])
-
-					var plasmidURL string
-					if len(bestHitKeys) == 1 {
-						plasmidURL = fmt.Sprintf("<a href=\"https://www.ncbi.nlm.nih.gov/nuccore/%s\" target=\"_blank\">%s</a>", bestHitKeys[0], bestHitKeys[0])
-
-						line = fmt.Sprintf("%s\t%s\t%f\n", s.Name(), bestHitKeys[0], bestHitValue)
-						fw.Write(([]byte)(line))
-
-					} else if len(bestHitKeys) > 1 {
-						for _, bestHitKey := range bestHitKeys {
-							plasmidURLs := []string{}
-							plasmidURLs = append(plasmidURLs, fmt.Sprintf("<a href=\"https://www.ncbi.nlm.nih.gov/nuccore/%s\" target=\"_blank\">%s</a>", bestHitKey, bestHitKeys[0]))
-							plasmidURL = strings.Join(plasmidURLs, "<br>")
-
-							line = fmt.Sprintf("%s\t%s\t%f\n", s.Name(), bestHitKey, bestHitValue)
-							fw.Write(([]byte)(line))
-						}
-					}
-
-					if bestHitValue >= threshold {
-						tb = append(tb, Row{AccID: s.Name(), Seq: seqSymbol, Length: s.Len(), Link: template.HTML(plasmidURL), Phylum: "Proteobacteria", Jaccard: bestHitValue})
-
-						plasmidSeq.ID = s.Name()
-						plasmidSeq.Seq = s.Slice().(alphabet.Letters)
-						plasmidSeq.Desc = fmt.Sprintf("Similar to %s (%f)", strings.Join(bestHitKeys, ":"), bestHitValue)
-
-						_, err := fastaw.Write(plasmidSeq)
-						if err != nil {
-							log.Printf("Failed to write: %s", err)
-						}
-					}
-
-					mutex.Unlock()
-				}
-			}()
+		id.replicons = index
+		run.Replicons = &info
+	}
+	if opts.Resistance != "" {
+		index, info, err := loadMarkers(fsys, opts.Resistance, markerCoverage, markerIdentity)
+		if err != nil {
+			return err
 		}
-		wg.Wait()
+		id.resistance = index
+		run.Resistance = &info
+	}
+	if opts.Replicons != "" || opts.Resistance != "" {
+		run.MarkerCoverage, run.MarkerIdentity = markerCoverage, markerIdentity
+	}
 
-		if err := os.MkdirAll("report/assets", 0777); err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
+	if err := fsys.MkdirAll(opts.OutDir, 0777); err != nil {
+		return err
+	}
+
+	cpus := runtime.NumCPU()
+	runtime.GOMAXPROCS(cpus)
+
+	var sketches Sketches
+	if sketchInput {
+		if sketches, err = loadSketches(fsys, inFile, plasmids); err != nil {
+			return err
 		}
-		copyFile("/assets/assets/pHash_logo.svg", "report/assets/pHash_logo.svg")
-		copyFile("/assets/assets/bootstrap.bundle.min.js", "report/assets/bootstrap.bundle.min.js")
-		copyFile("/assets/assets/bootstrap.min.css", "report/assets/bootstrap.min.css")
-		copyFile("/assets/assets/bootstrap.min.js", "report/assets/bootstrap.min.js")
+	}
 
-		f, err := Assets.Open("/assets/template.html.tpl")
+	var fastaw *fasta.Writer
+	if !sketchInput {
+		fwfasta, err := createOutput(fsys, fastaFile, opts.Force)
 		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
+			return err
 		}
-		defer f.Close()
+		defer fwfasta.Close()
+		fastaw = fasta.NewWriter(fwfasta, 60)
+	}
+	plasmidSeq := linear.NewSeq("", nil, alphabet.DNA)
 
-		contents, _ := ioutil.ReadAll(f)
+	fw, err := createOutput(fsys, outFile, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer fw.Close()
 
-		t, err := template.New("").Parse(string(contents))
-		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
+	rw, err := newResultWriter(fw, format)
+	if err != nil {
+		return err
+	}
+	// Copy numbers depend on the coverage of every contig, so with --coverage
+	// results are held back until the whole input has been read.
+	if !opts.Coverage {
+		if err := rw.WriteHeader(run); err != nil {
+			return err
 		}
+	}
 
-		report, err := os.Create("report/index.html")
+	var bw io.WriteCloser
+	if window > 0 {
+		bw, err = createOutput(fsys, bedFile, opts.Force)
 		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
+			return err
+		}
+		defer bw.Close()
+	}
+
+	var (
+		tb           []reportRow
+		similarities []float32
+		skipped      []skipSummary
+		contigs      int
+	)
+	mutex := new(sync.Mutex)
+
+	// Contigs finish out of order; results wait in pending until every
+	// earlier contig has been written, so outputs follow the input order.
+	pending := map[int]pendingResult{}
+	next := 0
+	var held []pendingResult
+
+	deliver := func(p pendingResult) error {
+		contigs++
+		if p.result.skipped != "" {
+			skipped = addSkipped(skipped, p.result.skipped, p.q.length)
+			return writeContigResult(rw, fastaw, plasmidSeq, bw, p.q, p.result)
+		}
+		similarities = append(similarities, p.result.similarity)
+		if p.result.row != nil {
+			tb = append(tb, *p.result.row)
+		}
+		return writeContigResult(rw, fastaw, plasmidSeq, bw, p.q, p.result)
+	}
+
+	handle := func(i int, q query) error {
+		result := id.identify(q)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		pending[i] = pendingResult{q: q, result: result}
+		for {
+			p, ok := pending[next]
+			if !ok {
+				return nil
+			}
+			delete(pending, next)
+			next++
+
+			if opts.Coverage {
+				held = append(held, p)
+			} else if err := deliver(p); err != nil {
+				return err
 			}
 		}
-		defer report.Close()
+	}
 
-		buff := new(bytes.Buffer)
-		fwt := io.Writer(buff)
+	if sketchInput {
+		err = readSketches(ctx, sketches, func(i int, contig ContigSketch) error {
+			return handle(i, sketchQuery(contig))
+		})
+	} else {
+		var f io.ReadCloser
+		if f, err = fsys.Open(inFile); err != nil {
+			return err
+		}
+		defer f.Close()
+		in := fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
+		err = readSequences(ctx, in, 1000, func(i int, s seq.Sequence) error {
+			return handle(i, id.newQuery(s))
+		})
+	}
+	if err != nil {
+		return err
+	}
 
-		if err := t.Execute(fwt, tb); err != nil {
-			log.Fatal(err)
+	if opts.Coverage {
+		run.BaselineCoverage = applyCopyNumbers(held)
+		if run.BaselineCoverage == 0 {
+			fmt.Fprintln(stderr, "Warning: no contig coverage found in the FASTA headers")
+		}
+		if err := rw.WriteHeader(run); err != nil {
+			return err
+		}
+		for _, p := range held {
+			if err := deliver(p); err != nil {
+				return err
+			}
 		}
-		report.Write(buff.Bytes())
+	}
+	if err := rw.Flush(); err != nil {
+		return err
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintln(stderr, formatSkipped(skipped))
+	}
 
-	},
+	report, err := createOutput(fsys, reportFile, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer report.Close()
+
+	data := reportData{
+		Run:       run,
+		Database:  newDatabaseInfo(fsys, db, binary),
+		Input:     inFile,
+		Generated: now().Format(time.RFC3339),
+		Contigs:   contigs,
+		Skipped:   skipped,
+		Rows:      tb,
+		Phyla:     summarizePhyla(tb),
+		Histogram: similarityHistogram(similarities, run.Threshold),
+	}
+	if opts.Coverage {
+		data.Candidates = rankCandidates(tb, run.BaselineCoverage)
+	}
+	if err := writeReport(report, reportTemplate, data); err != nil {
+		return err
+	}
+
+	return report.Close()
 }
 
-func calcSimilarity(array1 []*uint64, array2 *[]uint64, size uint64) float32 {
-	var match float64
-	for i := 0; i < int(size); i++ {
-		if *array1[i] == (*array2)[i] {
-			match++
+// applyCopyNumbers sets the copy number of every contig with known coverage
+// relative to the baseline of the contigs without a plasmid hit or labelled
+// chromosome, or of all contigs if there are none, and returns the baseline.
+func applyCopyNumbers(results []pendingResult) float64 {
+	var chromosomal, all []contigCoverage
+	for _, p := range results {
+		if p.result.coverage == nil {
+			continue
+		}
+		c := contigCoverage{length: p.q.length, coverage: *p.result.coverage}
+		all = append(all, c)
+		if !p.result.pass || p.result.class == classChromosome {
+			chromosomal = append(chromosomal, c)
 		}
 	}
-	var bitNum float64 = 64
-	similarity := (match / float64(size)) - math.Pow(2, -bitNum)/(1-math.Pow(2, -bitNum))
+	if len(chromosomal) == 0 {
+		chromosomal = all
+	}
+	baseline := baselineCoverage(chromosomal)
+	if baseline == 0 {
+		return 0
+	}
 
-	return float32(similarity)
+	for _, p := range results {
+		if p.result.coverage == nil {
+			continue
+		}
+		copyNumber := *p.result.coverage / baseline
+		for i := range p.result.records {
+			p.result.records[i].CopyNumber = &copyNumber
+		}
+		if p.result.row != nil {
+			p.result.row.CopyNumber = copyNumber
+		}
+	}
+	return baseline
 }
 
-func copyFile(srcName string, dstName string) {
-	src, err := Assets.Open(srcName)
-	if err != nil {
-		if err != io.EOF {
-			fmt.Println(err)
-			os.Exit(1)
+func writeContigResult(rw resultWriter, fastaw *fasta.Writer, plasmidSeq *linear.Seq, bw io.Writer, q query, result contigResult) error {
+	for _, record := range result.records {
+		if err := rw.Write(record); err != nil {
+			return err
 		}
 	}
-	defer src.Close()
 
-	dst, err := os.Create(dstName)
-	if err != nil {
-		if err != io.EOF {
-			fmt.Println(err)
-			os.Exit(1)
+	if result.row != nil && q.seq != nil {
+		plasmidSeq.ID = q.name
+		plasmidSeq.Seq = q.seq.(alphabet.Letters)
+		plasmidSeq.Desc = result.desc
+
+		if _, err := fastaw.Write(plasmidSeq); err != nil {
+			return err
 		}
 	}
-	defer dst.Close()
 
-	_, err = io.Copy(dst, src)
-	if err != nil {
-		if err != io.EOF {
-			fmt.Println(err)
-			os.Exit(1)
+	for _, hit := range result.windowHits {
+		line := fmt.Sprintf("%s\t%d\t%d\t%s\t%d\t.\n", q.name, hit.Start, hit.End, hit.AccID, bedScore(hit.Similarity))
+		if _, err := bw.Write(([]byte)(line)); err != nil {
+			return err
 		}
 	}
+
+	return nil
+}
+
+func searchBestHits(scheme sketchScheme, minHashValues []uint64, plasmidsRecords []PlasmidRecord, sketchSize uint64) ([]PlasmidRecord, float32) {
+	var (
+		bestHits     []PlasmidRecord
+		bestHitValue float32
+		similarity   float32
+	)
+
+	for _, record := range plasmidsRecords {
+		similarity = calcSimilarity(scheme, minHashValues, record.PlasmidMinHashValue, sketchSize)
+
+		if similarity > bestHitValue {
+			bestHits = []PlasmidRecord{record}
+			bestHitValue = similarity
+		} else if similarity == bestHitValue {
+			bestHits = append(bestHits, record)
+		}
+	}
+
+	return bestHits, bestHitValue
+}
+
+func countSharedHashes(array1 []uint64, array2 []uint64, size uint64) int {
+	var match int
+	for i := 0; i < int(size); i++ {
+		if array1[i] == array2[i] {
+			match++
+		}
+	}
+	return match
+}
+
+func calcSimilarity(scheme sketchScheme, array1 []uint64, array2 []uint64, size uint64) float32 {
+	shared, compared := scheme.Compare(array1, array2, size)
+	match := float64(shared)
+	var bitNum float64 = 64
+	similarity := (match / math.Max(float64(compared), 1)) - math.Pow(2, -bitNum)/(1-math.Pow(2, -bitNum))
+
+	return float32(similarity)
 }
@@ -0,0 +1,343 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	now = func() time.Time { return time.Date(2018, 11, 6, 12, 0, 0, 0, time.UTC) }
+}
+
+const (
+	testDB        = "db/synthetic.phash"
+	testQueries   = "queries.fna"
+	testRefPhylum = "refs.csv"
+)
+
+// newSyntheticFS writes four random reference plasmids with metadata, and
+// query contigs derived from them, to an in-memory file system:
+//
+//	exact      copy of the first reference
+//	fragment   reverse complement of a part of the second reference
+//	mutated    the third reference with 1% substitutions
+//	chimera    part of the fourth reference between random flanks
+//	unrelated  random sequence
+//
+// Query headers carry coverages, with unrelated as the chromosomal baseline.
+func newSyntheticFS() *memFS {
+	rng := rand.New(rand.NewSource(20181106))
+
+	phyla := []string{"Proteobacteria", "Firmicutes", "Actinobacteria", "Proteobacteria"}
+	var (
+		refs []fastaRecord
+		meta strings.Builder
+	)
+	for i, phylum := range phyla {
+		id := fmt.Sprintf("NZ_SYN%04d.1", i+1)
+		refs = append(refs, fastaRecord{id: id, seq: randomSequence(rng, 4000)})
+		fmt.Fprintf(&meta, "%s,%s\n", id, phylum)
+	}
+
+	queries := []fastaRecord{
+		{id: "exact", desc: "cov=50", seq: refs[0].seq},
+		{id: "fragment", desc: "cov=100", seq: reverseComplement(refs[1].seq[500:3500])},
+		{id: "mutated", desc: "cov=20", seq: mutate(rng, refs[2].seq, 0.01)},
+		{id: "chimera", desc: "cov=12.5", seq: randomSequence(rng, 6000) + refs[3].seq[:3000] + randomSequence(rng, 6000)},
+		{id: "unrelated", desc: "cov=10", seq: randomSequence(rng, 5000)},
+	}
+
+	fsys := newMemFS()
+	fsys.write("refs.fna", formatFasta(refs))
+	fsys.write(testRefPhylum, meta.String())
+	fsys.write(testQueries, formatFasta(queries))
+	return fsys
+}
+
+func makeSyntheticDB(t *testing.T, fsys *memFS) {
+	t.Helper()
+	opts := &makedbOptions{In: "refs.fna", Metadata: testRefPhylum, Out: testDB, Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+}
+
+func defaultIdentifyOptions(fsys fileSystem) *identifyOptions {
+	return &identifyOptions{
+		In:        testQueries,
+		DB:        testDB,
+		Threshold: 10,
+		Format:    "tsv",
+		OutDir:    "out",
+		Prefix:    "pHash",
+		Link:      defaultLinkPattern,
+		FS:        fsys,
+	}
+}
+
+func TestMakedbDeterministic(t *testing.T) {
+	a, b := newSyntheticFS(), newSyntheticFS()
+	makeSyntheticDB(t, a)
+	makeSyntheticDB(t, b)
+	if a.read(t, testDB) != b.read(t, testDB) {
+		t.Error("makedb wrote different databases for the same input")
+	}
+
+	binary := []byte(a.read(t, testDB))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plasmids.Kmer != 16 || plasmids.SketchSize != 128 || len(plasmids.Plasmid) != 4 {
+		t.Fatalf("got k=%d sketch=%d records=%d, want 16, 128 and 4", plasmids.Kmer, plasmids.SketchSize, len(plasmids.Plasmid))
+	}
+	for i, record := range plasmids.Plasmid {
+		if want := fmt.Sprintf("NZ_SYN%04d.1", i+1); record.AccID != want {
+			t.Errorf("record %d is %s, want %s", i, record.AccID, want)
+		}
+		if len(record.PlasmidMinHashValue) != 128 {
+			t.Errorf("%s has %d hashes, want 128", record.AccID, len(record.PlasmidMinHashValue))
+		}
+	}
+	if got := plasmids.Plasmid[1].Phylum; got != "Firmicutes" {
+		t.Errorf("phylum of NZ_SYN0002.1 is %q, want Firmicutes", got)
+	}
+}
+
+func TestMakedbOptions(t *testing.T) {
+	for _, size := range [][2]int{{0, 128}, {-3, 128}, {16, 0}, {16, -1}} {
+		fsys := newSyntheticFS()
+		opts := &makedbOptions{In: "refs.fna", Out: testDB, Kmer: size[0], Sketch: size[1], FS: fsys}
+		err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard)
+		if err == nil || err.Error() != "--kmer and --sketch must be positive" {
+			t.Errorf("k=%d sketch=%d: got error %v", size[0], size[1], err)
+		}
+	}
+}
+
+func TestIdentifyGolden(t *testing.T) {
+	for _, format := range resultFormats {
+		t.Run(format, func(t *testing.T) {
+			fsys := newSyntheticFS()
+			makeSyntheticDB(t, fsys)
+			fsys.write("report.tpl", mustReadFile(t, "testdata/report.tpl"))
+
+			opts := defaultIdentifyOptions(fsys)
+			opts.Format = format
+			opts.Window = 2000
+			opts.Template = "report.tpl"
+			opts.Coverage = true
+			if err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("identify: %v", err)
+			}
+
+			checkGolden(t, "identify."+format, fsys.read(t, "out/pHash."+format))
+			if format != "tsv" {
+				return
+			}
+			checkGolden(t, "identify_plasmids.fna", fsys.read(t, "out/pHash_plasmids.fna"))
+			checkGolden(t, "identify.bed", fsys.read(t, "out/pHash.bed"))
+			checkGolden(t, "identify_report.txt", fsys.read(t, "out/pHash_report.html"))
+		})
+	}
+}
+
+func TestIdentifyBuiltinReport(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	if err := runIdentify(context.Background(), defaultIdentifyOptions(fsys), ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	report := fsys.read(t, "out/pHash_report.html")
+	for _, want := range []string{"<html", "NZ_SYN0001.1", "https://www.ncbi.nlm.nih.gov/nuccore/NZ_SYN0003.1", "Firmicutes"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report does not contain %q", want)
+		}
+	}
+	if strings.Contains(report, "unrelated") {
+		t.Error("report lists a contig below the threshold")
+	}
+}
+
+func TestIdentifyErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(opts *identifyOptions, fsys *memFS)
+		want   string
+	}{
+		{
+			name:   "missing input",
+			modify: func(opts *identifyOptions, fsys *memFS) { opts.In = "" },
+			want:   "--in and --db are required",
+		},
+		{
+			name:   "unknown format",
+			modify: func(opts *identifyOptions, fsys *memFS) { opts.Format = "xml" },
+			want:   "--format must be one of",
+		},
+		{
+			name:   "missing database",
+			modify: func(opts *identifyOptions, fsys *memFS) { opts.DB = "missing.phash" },
+			want:   "missing.phash",
+		},
+		{
+			name:   "window shorter than k",
+			modify: func(opts *identifyOptions, fsys *memFS) { opts.Window = 10 },
+			want:   "--window must be at least the k-mer length (16)",
+		},
+		{
+			name:   "existing output",
+			modify: func(opts *identifyOptions, fsys *memFS) { fsys.write("out/pHash.tsv", "") },
+			want:   "output already exists: out/pHash.tsv (use --force to overwrite)",
+		},
+		{
+			name: "existing output with force",
+			modify: func(opts *identifyOptions, fsys *memFS) {
+				fsys.write("out/pHash.tsv", "")
+				opts.Force = true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newSyntheticFS()
+			makeSyntheticDB(t, fsys)
+			opts := defaultIdentifyOptions(fsys)
+			tt.modify(opts, fsys)
+
+			err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard)
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.want != "" && err == nil:
+				t.Errorf("no error, want %q", tt.want)
+			case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+				t.Errorf("error %q does not contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestIdentifyShortContigs(t *testing.T) {
+	fsys := newSyntheticFS()
+	refs := fsys.read(t, "refs.fna")
+	fsys.write("refs.fna", refs+">tiny\nACGT\n")
+	var makedbLog strings.Builder
+	opts := &makedbOptions{In: "refs.fna", Metadata: testRefPhylum, Out: testDB, Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runMakedb(context.Background(), opts, &makedbLog, &makedbLog); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+	if !strings.Contains(makedbLog.String(), "Warning: tiny has no k-mers and was skipped") {
+		t.Errorf("makedb did not warn about a reference shorter than k:\n%s", makedbLog.String())
+	}
+
+	rng := rand.New(rand.NewSource(50))
+	exact := strings.SplitN(refs, ">", 3)[1]
+	fsys.write(testQueries, ">"+exact+formatFasta([]fastaRecord{
+		{id: "empty"},
+		{id: "two", seq: "AC"},
+		{id: "short", seq: randomSequence(rng, 10)},
+		{id: "gap", seq: strings.Repeat("N", 400)},
+		{id: "small", seq: randomSequence(rng, 500)},
+	}))
+
+	tests := []struct {
+		name      string
+		minLength int
+		minKmers  int
+		rows      [][2]string // contig and skip reason
+		want      string
+	}{
+		{
+			name: "defaults",
+			rows: [][2]string{
+				{"NZ_SYN0001.1", ""},
+				{"empty", "without k-mers"},
+				{"two", "without k-mers"},
+				{"short", "without k-mers"},
+				{"gap", "without k-mers"},
+				{"small", ""},
+			},
+			want: "Skipped 4 contigs: 4 without k-mers (412 bases)",
+		},
+		{
+			name:      "filters",
+			minLength: 300,
+			minKmers:  1000,
+			rows: [][2]string{
+				{"NZ_SYN0001.1", ""},
+				{"empty", "shorter than 300 bases"},
+				{"two", "shorter than 300 bases"},
+				{"short", "shorter than 300 bases"},
+				{"gap", "without k-mers"},
+				{"small", "with fewer than 1000 k-mers"},
+			},
+			want: "Skipped 5 contigs: 3 shorter than 300 bases (12 bases), 1 without k-mers (400 bases), 1 with fewer than 1000 k-mers (500 bases)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identify := defaultIdentifyOptions(fsys)
+			identify.MinLength, identify.MinKmers = tt.minLength, tt.minKmers
+			identify.Threshold = 0
+			identify.Force = true
+			var log strings.Builder
+			if err := runIdentify(context.Background(), identify, ioutil.Discard, &log); err != nil {
+				t.Fatalf("identify: %v", err)
+			}
+			if !strings.Contains(log.String(), tt.want) {
+				t.Errorf("skipped contigs reported as\n%s\nwant %q", log.String(), tt.want)
+			}
+
+			f, err := fsys.Open("out/pHash.tsv")
+			if err != nil {
+				t.Fatal(err)
+			}
+			records, err := readResults(f)
+			f.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var rows [][2]string
+			for _, record := range records {
+				rows = append(rows, [2]string{record.ContigID, record.Skipped})
+				if record.Skipped != "" && (record.HitAccID != "" || record.PassThreshold || record.SketchSize != 128) {
+					t.Errorf("skipped contig %s has result %+v", record.ContigID, record)
+				}
+			}
+			if !reflect.DeepEqual(rows, tt.rows) {
+				t.Errorf("result rows %v, want %v", rows, tt.rows)
+			}
+			if report := fsys.read(t, "out/pHash_report.html"); !strings.Contains(report, "without k-mers") {
+				t.Error("report does not list the skipped contigs")
+			}
+		})
+	}
+
+	for _, negative := range [][2]int{{-1, 0}, {0, -1}} {
+		identify := defaultIdentifyOptions(fsys)
+		identify.MinLength, identify.MinKmers = negative[0], negative[1]
+		err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard)
+		if err == nil || err.Error() != "--min-length and --min-kmers must not be negative" {
+			t.Errorf("min length %d and min k-mers %d: got error %v", negative[0], negative[1], err)
+		}
+	}
+}
+
+func mustReadFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -1,53 +1,188 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
+const defaultDatabaseURL = "https://zenodo.org/record/1991549/files/plasmidDB11062018.phash"
+
+type initOptions struct {
+	URL      string
+	Mirror   string
+	Manifest string
+	SHA256   string
+	NoVerify bool
+	Catalog  string
+	List     bool
+	DB       string
+	DataDir  string
+	Client   *http.Client
+}
+
 func init() {
-	RootCmd.AddCommand(initCmd)
+	RootCmd.AddCommand(newInitCmd())
+}
+
+func newInitCmd() *cobra.Command {
+	opts := &initOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Download reference plasmid database",
+		Long:  "Download reference plasmid database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInit(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.URL, "url", defaultDatabaseURL, "URL of the database (http, https or file)")
+	cmd.Flags().StringVar(&opts.Mirror, "mirror", "", "Base URL of a mirror holding the database and its SHA256SUMS")
+	cmd.Flags().StringVar(&opts.Manifest, "manifest", "", "URL of the SHA-256 manifest (default SHA256SUMS next to the database)")
+	cmd.Flags().StringVar(&opts.SHA256, "sha256", "", "Expected SHA-256 of the database, overrides the manifest")
+	cmd.Flags().BoolVar(&opts.NoVerify, "no-verify", false, "Install the database even if no checksum is known for it")
+	cmd.Flags().StringVar(&opts.Catalog, "catalog", "", "Catalog of databases, a URL or a file (default built-in)")
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List databases in the catalog")
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Install database NAME or NAME@VERSION from the catalog")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+
+	return cmd
 }
 
-var initCmd = &cobra.Command{
-	Use:   "init",
-	Short: "Download reference plasmid database",
-	Long:  "Download reference plasmid database",
-	Run: func(cmd *cobra.Command, args []string) {
+// runInit downloads into the working directory, or into the data directory
+// when installing from the catalog. Unlike makedb and identify it always uses
+// the OS file system, since downloads are resumed and renamed in place.
+func runInit(ctx context.Context, opts *initOptions, stdout io.Writer, stderr io.Writer) error {
+	client := opts.Client
+	if client == nil {
+		client = newDownloadClient()
+	}
 
-		url := "https://zenodo.org/record/1991549/files/plasmidDB11062018.phash"
+	url := opts.URL
+	expected := strings.ToLower(opts.SHA256)
+	var (
+		dest  string
+		entry *catalogEntry
+	)
 
-		fmt.Println("Plasmid database is being downloaded...")
-		response, err := http.Get(url)
+	if opts.List || opts.DB != "" {
+		c, err := loadCatalog(ctx, client, opts.Catalog)
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			return err
 		}
 
-		body, err := ioutil.ReadAll(response.Body)
+		if opts.List {
+			printCatalog(stdout, c, opts.DataDir)
+			return nil
+		}
 
+		found, err := c.find(opts.DB)
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			return err
+		}
+		entry = &found
+		if err := os.MkdirAll(opts.DataDir, 0777); err != nil {
+			return err
 		}
+		url = entry.URL
+		dest = filepath.Join(opts.DataDir, entry.fileName())
+		if expected == "" {
+			expected = strings.ToLower(entry.SHA256)
+		}
+	} else if found, ok := builtinEntry(url); ok {
+		// A download of a built-in database is verified against its
+		// catalog entry as with --db.
+		entry = &found
+		if expected == "" {
+			expected = strings.ToLower(entry.SHA256)
+		}
+	}
+
+	if opts.Mirror != "" {
+		_, name := path.Split(url)
+		url = strings.TrimRight(opts.Mirror, "/") + "/" + name
+	}
+	base, filename := path.Split(url)
+	if dest == "" {
+		dest = filename
+	}
 
-		_, filename := path.Split(url)
+	if expected == "" {
+		manifestURL := opts.Manifest
+		if manifestURL == "" {
+			manifestURL = base + "SHA256SUMS"
+		}
+		manifest, err := fetchManifest(ctx, client, manifestURL)
+		if errors.Is(err, errNotFound) && opts.Manifest == "" {
+			switch {
+			case opts.NoVerify:
+				fmt.Fprintf(stderr, "Warning: no checksum manifest at %s, the download is not verified\n", manifestURL)
+			case entry != nil && entry.builtin:
+				fmt.Fprintf(stderr, "Warning: no SHA-256 is pinned for %s@%s and there is no manifest at %s, the download is only checked against the catalog\n", entry.Name, entry.Version, manifestURL)
+			default:
+				name := filename
+				if entry != nil {
+					name = entry.Name + "@" + entry.Version
+				}
+				return fmt.Errorf("no checksum for %s: no sha256 is given and there is no manifest at %s (give --sha256 or --manifest, or --no-verify to install it unverified)", name, manifestURL)
+			}
+		} else if err != nil {
+			return err
+		} else if expected = manifest[filename]; expected == "" {
+			return fmt.Errorf("%s is not listed in %s", filename, manifestURL)
+		}
+	}
 
-		file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0666)
+	fmt.Fprintln(stdout, "Plasmid database is being downloaded...")
+	part, err := downloadFile(ctx, client, url, dest, stderr)
+	if err != nil {
+		return err
+	}
 
+	if expected != "" {
+		actual, err := fileSHA256(part)
 		if err != nil {
-			fmt.Println(err)
+			return err
 		}
+		if actual != expected {
+			os.Remove(part)
+			return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", filename, expected, actual)
+		}
+	}
+
+	if entry != nil {
+		if err := checkCatalogEntry(part, *entry, stdout); err != nil {
+			os.Remove(part)
+			return err
+		}
+	}
 
-		defer func() {
-			file.Close()
-		}()
+	return os.Rename(part, dest)
+}
 
-		file.Write(body)
-	},
+// checkCatalogEntry checks the parameters of a downloaded database against
+// those listed in the catalog, where known, and prints them.
+func checkCatalogEntry(path string, entry catalogEntry, stdout io.Writer) error {
+	binary, err := readFile(osFS{}, path)
+	if err != nil {
+		return err
+	}
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		return err
+	}
+	if (entry.Kmer != 0 && entry.Kmer != plasmids.Kmer) || (entry.SketchSize != 0 && entry.SketchSize != plasmids.SketchSize) || (entry.Records != 0 && entry.Records != len(plasmids.Plasmid)) {
+		return fmt.Errorf("%s@%s does not match the catalog: k=%d, sketch size %d and %d records, the catalog lists k=%d, sketch size %d and %d records", entry.Name, entry.Version, plasmids.Kmer, plasmids.SketchSize, len(plasmids.Plasmid), entry.Kmer, entry.SketchSize, entry.Records)
+	}
+	fmt.Fprintf(stdout, "Installed %s@%s (k=%d, sketch size %d, %d records)\n", entry.Name, entry.Version, plasmids.Kmer, plasmids.SketchSize, len(plasmids.Plasmid))
+	return nil
 }
@@ -1,195 +1,218 @@
 package cmd
 
 import (
+	"context"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
-	"os"
 	"runtime"
 	"strings"
-	"sync"
 
-	"github.com/OneOfOne/xxhash"
-	"github.com/biogo/biogo/alphabet"
-	"github.com/biogo/biogo/io/seqio/fasta"
-	"github.com/biogo/biogo/seq/linear"
 	"github.com/spf13/cobra"
 )
 
+type makedbOptions struct {
+	In          string
+	Metadata    string
+	Out         string
+	Kmer        int
+	Sketch      int
+	Chromosomes string
+	Chunk       int
+	Hash        string
+	Scheme      string
+	Abundance   bool
+	Mask        string
+	MaxKmerFreq float64
+	Force       bool
+	FS          fileSystem
+}
+
 func init() {
-	RootCmd.AddCommand(makedbCmd)
-	makedbCmd.Flags().StringVarP(&o.optIn, "in", "i", "default", "Input FASTA file")
-	makedbCmd.Flags().StringVarP(&o.optMetadata, "meta", "m", "", "Input FASTA file")
-	makedbCmd.Flags().StringVarP(&o.optBuildOut, "out", "o", "reference.phash", "Database")
-	makedbCmd.Flags().IntVarP(&o.optKmer, "kmer", "k", 16, "Length of k-mer")
-	makedbCmd.Flags().IntVarP(&o.optSketch, "sketch", "s", 512, "Sketch size")
+	RootCmd.AddCommand(newMakedbCmd())
 }
 
-var makedbCmd = &cobra.Command{
-	Use:   "makedb",
-	Short: "Builder of plasmid database",
-	Long:  "Builder of plasmid database using MinHash",
-	Run: func(cmd *cobra.Command, args []string) {
+func newMakedbCmd() *cobra.Command {
+	opts := &makedbOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "makedb",
+		Short: "Builder of plasmid database",
+		Long:  "Builder of plasmid database using MinHash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMakedb(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Input FASTA file")
+	cmd.Flags().StringVarP(&opts.Metadata, "meta", "m", "", "Metadata CSV file (accession,phylum)")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "reference.phash", "Database")
+	cmd.Flags().IntVarP(&opts.Kmer, "kmer", "k", 16, "Length of k-mer")
+	cmd.Flags().IntVarP(&opts.Sketch, "sketch", "s", 512, "Sketch size")
+	cmd.Flags().StringVarP(&opts.Chromosomes, "chromosomes", "c", "", "FASTA file of chromosomes to tell plasmids from chromosomes")
+	cmd.Flags().IntVar(&opts.Chunk, "chunk", 20000, "Length of the overlapping chunks chromosomes are sketched in")
+	cmd.Flags().StringVar(&opts.Mask, "mask", "", "FASTA file of sequences, such as IS elements and integrons, whose k-mers are left out of sketches")
+	cmd.Flags().Float64Var(&opts.MaxKmerFreq, "max-kmer-freq", 0, "Leave out k-mers found in more than this percentage of plasmids (0 disables)")
+	cmd.Flags().StringVar(&opts.Hash, "hash", "xxhash64", "Hash function of sketches ("+strings.Join(hasherNames(), ", ")+")")
+	cmd.Flags().StringVar(&opts.Scheme, "scheme", "kmins", "Sketch scheme ("+strings.Join(schemeNames(), ", ")+")")
+	cmd.Flags().BoolVar(&opts.Abundance, "abundance", false, "Keep the counts of the k-mers of bottomk sketches")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
 
-		if o.optIn == "" || o.optBuildOut == "" {
-			fmt.Println("--in and --out are required")
-			cmd.Help()
-			os.Exit(0)
+func runMakedb(ctx context.Context, opts *makedbOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.Out == "" {
+		return errors.New("--in and --out are required")
+	}
+	if opts.Kmer < 1 || opts.Sketch < 1 {
+		return errors.New("--kmer and --sketch must be positive")
+	}
+	if opts.Chromosomes != "" && opts.Chunk < opts.Kmer {
+		return errors.New("--chunk must be at least the k-mer length")
+	}
+	if opts.MaxKmerFreq < 0 || opts.MaxKmerFreq > 100 {
+		return errors.New("--max-kmer-freq must be between 0 and 100")
+	}
+	hasher, err := lookupHasher(opts.Hash)
+	if err != nil {
+		return err
+	}
+	scheme, err := lookupScheme(opts.Scheme)
+	if err != nil {
+		return err
+	}
+	if err := checkAbundance(scheme, opts.Abundance); err != nil {
+		return err
+	}
+	fsys := orOSFS(opts.FS)
+	if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+		return err
+	}
+
+	inFile := opts.In
+	outFile := opts.Out
+	k := opts.Kmer
+	sketchSize := uint64(opts.Sketch)
+	metadata := opts.Metadata
+
+	cpus := runtime.NumCPU()
+	runtime.GOMAXPROCS(cpus)
+
+	phylumMap := map[string]string{}
+	if len(metadata) > 0 {
+		csvfile, err := fsys.Open(metadata)
+		if err != nil {
+			return err
 		}
+		defer csvfile.Close()
 
-		inFile := o.optIn
-		outFile := o.optBuildOut
-		k := o.optKmer
-		sketchSize := uint64(o.optSketch)
-		metadata := o.optMetadata
-
-		cpus := runtime.NumCPU()
-		runtime.GOMAXPROCS(cpus)
-
-		phylumMap := map[string]string{}
-		if len(metadata) > 0 {
-			csvfile, err := os.Open(metadata)
-			if err != nil {
-				if err != io.EOF {
-					fmt.Println(err)
-					os.Exit(1)
-				}
-				return
-			}
-			defer csvfile.Close()
-
-			csvreader := csv.NewReader(csvfile)
-
-			if metadata != "" {
-				for {
-					record, _ := csvreader.Read()
-					if err != nil {
-						if err != io.EOF {
-							fmt.Println(err)
-							os.Exit(1)
-						}
-					}
-					if len(record) == 0 {
-						break
-					}
-					phylumMap[record[0]] = record[1]
-				}
-			}
+		phylumMap, err = readMetadata(csvfile)
+		if err != nil {
+			return err
 		}
+	}
 
-		var in *fasta.Reader
-		if inFile == "" {
-			in = fasta.NewReader(os.Stdin, linear.NewSeq("", nil, alphabet.DNA))
-		} else if f, err := os.Open(inFile); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v.", err)
-			os.Exit(1)
-		} else {
-			in = fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
-			defer f.Close()
+	mask := kmerMask{}
+	if opts.Mask != "" {
+		if err := addFastaMask(ctx, fsys, opts.Mask, k, hasher, mask); err != nil {
+			return err
 		}
-
-		var wg sync.WaitGroup
-		mutex := new(sync.Mutex)
-
-		ambiguousDnaComplement := strings.NewReplacer(
-			"A", "T",
-			"C", "G",
-			"G", "C",
-			"T", "A",
-			"M", "K",
-			"R", "Y",
-			"Y", "R",
-			"K", "M",
-			"V", "B",
-			"H", "D",
-			"D", "H",
-			"B", "V")
-
-		plasmidsRecords := []PlasmidRecord{}
-
-		for i := 0; i < 1024; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				for {
-					mutex.Lock()
-					s, err := in.Read()
-					mutex.Unlock()
-
-					if err != nil {
-						if err != io.EOF {
-							fmt.Println(err)
-							os.Exit(1)
-						}
-						break
-					}
-
-					read := s.Slice()
-					kmerNum := read.Len() - (k - 1)
-					kmerMap := make(map[string]struct{}, kmerNum)
-
-					for i := 0; i < kmerNum; i++ {
-						seq := read.Slice(i, i+k).(alphabet.Letters).String()
-						if strings.Index(seq, "N") == -1 {
-							reverseComplement := ambiguousDnaComplement.Replace(rev(&seq))
-							h1 := xxhash.ChecksumString32S(seq, 0)
-							h2 := xxhash.ChecksumString32S(reverseComplement, 0)
-
-							var canonicalKmer string
-							if h1 > h2 {
-								canonicalKmer = seq
-							} else {
-								canonicalKmer = reverseComplement
-							}
-							kmerMap[canonicalKmer] = struct{}{}
-						}
-					}
-
-					kmerList := make([][]byte, len(kmerMap))
-					for key := range kmerMap {
-						kmerList = append(kmerList, []byte(key))
-					}
-
-					minHashValues := make([]uint64, sketchSize)
-
-					for i := uint64(0); i < sketchSize; i++ {
-						minValue := uint64((1 << 64) - 1)
-						for _, kmer := range kmerList {
-							xxhv := xxhash.Checksum64S(kmer, i)
-							if minValue > xxhv {
-								minValue = xxhv
-							}
-						}
-						minHashValues[i] = minValue
-					}
-
-					mutex.Lock()
-					phylum := "---"
-					if value, ok := phylumMap[s.Name()]; ok {
-						phylum = value
-					}
-					plasmidsRecords = append(plasmidsRecords, PlasmidRecord{AccID: s.Name(), Phylum: phylum, PlasmidMinHashValue: minHashValues})
-					mutex.Unlock()
-				}
-			}()
+		fmt.Fprintf(stderr, "Masked %d k-mers of %s\n", len(mask), opts.Mask)
+	}
+	if opts.MaxKmerFreq > 0 {
+		added, err := addFrequentKmers(ctx, fsys, inFile, k, hasher, opts.MaxKmerFreq/100, mask)
+		if err != nil {
+			return err
 		}
-		wg.Wait()
-
-		plasmids := Plasmids{
-			SketchSize: sketchSize,
-			Kmer:       k,
-			Plasmid:    plasmidsRecords,
+		fmt.Fprintf(stderr, "Masked %d k-mers found in more than %g%% of plasmids\n", added, opts.MaxKmerFreq)
+	}
+
+	sk := sketcher{k: k, sketchSize: sketchSize, hasher: hasher, scheme: scheme, abundance: opts.Abundance, mask: mask}
+	plasmidsRecords, err := sketchRecords(ctx, fsys, inFile, sk, phylumMap, 0, stderr)
+	if err != nil {
+		return err
+	}
+
+	var chromosomeRecords []PlasmidRecord
+	if opts.Chromosomes != "" {
+		chromosomeRecords, err = sketchRecords(ctx, fsys, opts.Chromosomes, sk, phylumMap, opts.Chunk, stderr)
+		if err != nil {
+			return err
 		}
+	}
+
+	plasmids := Plasmids{
+		SketchSize: sketchSize,
+		Kmer:       k,
+		Plasmid:    plasmidsRecords,
+		Chromosome: chromosomeRecords,
+		Hash:       hashHeader(hasher),
+		Scheme:     schemeHeader(scheme),
+		Abundance:  opts.Abundance,
+		Mask:       mask.keys(),
+	}
+
+	buf, err := messagePackEncoding(&plasmids)
+	if err != nil {
+		return err
+	}
+
+	file, err := createOutput(fsys, outFile, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(buf); err != nil {
+		return err
+	}
+	return file.Close()
+}
 
-		file, err := os.Create(outFile)
+// readMetadata reads a metadata CSV file of accession and value columns, such
+// as the phyla of makedb. Rows with fewer columns are skipped.
+func readMetadata(r io.Reader) (map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	metadata := map[string]string{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return metadata, nil
+		}
 		if err != nil {
-			if err != io.EOF {
-				fmt.Println(err)
-				os.Exit(1)
-			}
+			return nil, err
+		}
+		if len(record) >= 2 {
+			metadata[record[0]] = record[1]
 		}
-		defer file.Close()
+	}
+}
 
-		file.Write(messagePackEncoding(&plasmids))
-	},
+// sketchRecords sketches the sequences of a FASTA file into database records
+// with the phyla of phylumMap, in chunks with a chunk length. Sequences
+// without k-mers, such as those shorter than k, would match each other
+// perfectly and are left out.
+func sketchRecords(ctx context.Context, fsys fileSystem, name string, sk sketcher, phylumMap map[string]string, chunk int, stderr io.Writer) ([]PlasmidRecord, error) {
+	contigs, err := sk.sketchFasta(ctx, fsys, name, 1024, chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []PlasmidRecord
+	for _, contig := range contigs {
+		if contig.Kmers == 0 {
+			fmt.Fprintf(stderr, "Warning: %s has no k-mers and was skipped\n", contig.Name)
+			continue
+		}
+		phylum := "---"
+		if value, ok := phylumMap[contig.source]; ok {
+			phylum = value
+		}
+		records = append(records, PlasmidRecord{AccID: contig.Name, Phylum: phylum, PlasmidMinHashValue: contig.MinHashValue, Abundances: contig.Abundances})
+	}
+	return records, nil
 }
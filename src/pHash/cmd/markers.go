@@ -0,0 +1,377 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/OneOfOne/xxhash"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/spf13/cobra"
+	"github.com/ugorji/go/codec"
+)
+
+// Marker databases hold short reference sequences, such as plasmid replicons,
+// as the canonical k-mers along each sequence. Contigs are searched for them
+// by exact k-mer lookup instead of MinHash, which cannot find a gene of a
+// few hundred bases in a contig of a hundred kilobases.
+type (
+	MarkerRecord struct {
+		Name   string
+		Group  string
+		Length int
+		Kmers  []uint64 // hashes of the canonical k-mers by position, 0 if the k-mer has N
+	}
+
+	Markers struct {
+		Kmer    int
+		Markers []MarkerRecord
+	}
+
+	// markerHit is a marker found in a contig. Coverage is the fraction of
+	// the marker covered by shared k-mers and Identity is estimated from the
+	// fraction of k-mers shared within the covered span.
+	markerHit struct {
+		Name     string  `json:"name"`
+		Group    string  `json:"group"`
+		Start    int     `json:"start"`
+		End      int     `json:"end"`
+		Coverage float64 `json:"coverage"`
+		Identity float64 `json:"identity"`
+	}
+
+	// markerDBInfo describes a marker database used for a run.
+	markerDBInfo struct {
+		Path    string `json:"path"`
+		Kmer    int    `json:"kmer"`
+		Markers int    `json:"markers"`
+	}
+
+	markerPosition struct {
+		marker int32
+		pos    int32
+	}
+
+	// markerIndex looks up the markers and positions of a k-mer hash.
+	markerIndex struct {
+		k           int
+		markers     []MarkerRecord
+		positions   map[uint64][]markerPosition
+		minCoverage float64
+		minIdentity float64
+	}
+)
+
+type markerdbOptions struct {
+	In       string
+	Metadata string
+	Out      string
+	Kmer     int
+	Force    bool
+	FS       fileSystem
+}
+
+func init() {
+	RootCmd.AddCommand(newMarkerdbCmd())
+}
+
+func newMarkerdbCmd() *cobra.Command {
+	opts := &markerdbOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "markerdb",
+		Short: "Builder of marker database",
+		Long:  "Builder of marker database, such as plasmid replicons, for exact k-mer search",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMarkerdb(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Input FASTA file of markers")
+	cmd.Flags().StringVarP(&opts.Metadata, "meta", "m", "", "Metadata CSV file (marker,group)")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "markers.phm", "Marker database")
+	cmd.Flags().IntVarP(&opts.Kmer, "kmer", "k", 18, "Length of k-mer")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runMarkerdb(ctx context.Context, opts *markerdbOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.Out == "" {
+		return errors.New("--in and --out are required")
+	}
+	if opts.Kmer < 8 || opts.Kmer > 32 {
+		return errors.New("--kmer must be between 8 and 32")
+	}
+	fsys := orOSFS(opts.FS)
+	if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+		return err
+	}
+
+	groups := map[string]string{}
+	if opts.Metadata != "" {
+		f, err := fsys.Open(opts.Metadata)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if groups, err = readMetadata(f); err != nil {
+			return err
+		}
+	}
+
+	f, err := fsys.Open(opts.In)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	in := fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
+
+	mutex := new(sync.Mutex)
+	records := map[int]MarkerRecord{}
+	err = readSequences(ctx, in, 64, func(i int, s seq.Sequence) error {
+		group, ok := groups[s.Name()]
+		if !ok {
+			group = markerGroup(s.Name())
+		}
+		record := MarkerRecord{Name: s.Name(), Group: group, Length: s.Len(), Kmers: markerKmers(s.Slice(), opts.Kmer)}
+
+		mutex.Lock()
+		records[i] = record
+		mutex.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	markers := Markers{Kmer: opts.Kmer}
+	for i := 0; i < len(records); i++ {
+		if len(records[i].Kmers) == 0 {
+			fmt.Fprintf(stderr, "Warning: %s is shorter than k and was skipped\n", records[i].Name)
+			continue
+		}
+		markers.Markers = append(markers.Markers, records[i])
+	}
+
+	buf := make([]byte, 0, 64)
+	if err := codec.NewEncoderBytes(&buf, &mh).Encode(markers); err != nil {
+		return fmt.Errorf("error encoding markers to MessagePack: %v", err)
+	}
+
+	file, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Write(buf); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// markerGroup is the group of a marker without metadata, the name up to the
+// first "_" as in PlasmidFinder (IncFII(pHN7A8)_1_pHN7A8_JN232517) and
+// ResFinder (blaTEM-1B_1_AY458016) names.
+func markerGroup(name string) string {
+	if i := strings.Index(name, "_"); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// markerKmers hashes the canonical k-mer at every position of read.
+func markerKmers(read alphabet.Slice, k int) []uint64 {
+	if read.Len() < k {
+		return nil
+	}
+	kmers := make([]uint64, read.Len()-k+1)
+	for i := range kmers {
+		if kmer, ok := canonicalKmerAt(read, i, k, defaultHasher); ok {
+			kmers[i] = xxhash.ChecksumString64(kmer)
+		}
+	}
+	return kmers
+}
+
+// loadMarkers reads a marker database built by markerdb and indexes it.
+func loadMarkers(fsys fileSystem, path string, minCoverage float64, minIdentity float64) (*markerIndex, markerDBInfo, error) {
+	binary, err := readFile(fsys, path)
+	if err != nil {
+		return nil, markerDBInfo{}, err
+	}
+	var markers Markers
+	if err := codec.NewDecoderBytes(binary, &mh).Decode(&markers); err != nil {
+		return nil, markerDBInfo{}, fmt.Errorf("error decoding markers from MessagePack: %v", err)
+	}
+	if len(markers.Markers) == 0 {
+		return nil, markerDBInfo{}, fmt.Errorf("%s is not a marker database built by markerdb", path)
+	}
+
+	index := &markerIndex{
+		k:           markers.Kmer,
+		markers:     markers.Markers,
+		positions:   map[uint64][]markerPosition{},
+		minCoverage: minCoverage,
+		minIdentity: minIdentity,
+	}
+	for m, marker := range markers.Markers {
+		for pos, h := range marker.Kmers {
+			if h != 0 {
+				index.positions[h] = append(index.positions[h], markerPosition{marker: int32(m), pos: int32(pos)})
+			}
+		}
+	}
+
+	return index, markerDBInfo{Path: path, Kmer: markers.Kmer, Markers: len(markers.Markers)}, nil
+}
+
+// markerMatch is a k-mer shared by a marker and a contig.
+type markerMatch struct{ markerPos, contigPos int }
+
+// scan finds the markers in read reaching the minimum coverage and identity.
+// Matches of a marker are split into loci where they are further apart than
+// the marker is long, so that every copy of a duplicated gene is a hit of its
+// own. Where hits overlap, such as alleles of one gene, only the best is kept.
+func (m *markerIndex) scan(read alphabet.Slice) []markerHit {
+	matches := map[int32][]markerMatch{}
+	for i := 0; i+m.k <= read.Len(); i++ {
+		kmer, ok := canonicalKmerAt(read, i, m.k, defaultHasher)
+		if !ok {
+			continue
+		}
+		for _, p := range m.positions[xxhash.ChecksumString64(kmer)] {
+			matches[p.marker] = append(matches[p.marker], markerMatch{markerPos: int(p.pos), contigPos: i})
+		}
+	}
+
+	var hits []markerHit
+	for markerID, found := range matches {
+		marker := m.markers[markerID]
+		// Matches are in contig order.
+		from := 0
+		for i := 1; i <= len(found); i++ {
+			if i < len(found) && found[i].contigPos-found[i-1].contigPos <= marker.Length {
+				continue
+			}
+			if hit := m.locusHit(marker, found[from:i]); hit.Coverage >= m.minCoverage && hit.Identity >= m.minIdentity {
+				hits = append(hits, hit)
+			}
+			from = i
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		si, sj := hits[i].Coverage*hits[i].Identity, hits[j].Coverage*hits[j].Identity
+		if si != sj {
+			return si > sj
+		}
+		return hits[i].Name < hits[j].Name
+	})
+	var best []markerHit
+	for _, hit := range hits {
+		overlapping := false
+		for _, b := range best {
+			if overlapsHalf(hit, b) {
+				overlapping = true
+				break
+			}
+		}
+		if !overlapping {
+			best = append(best, hit)
+		}
+	}
+	sort.SliceStable(best, func(i, j int) bool { return best[i].Start < best[j].Start })
+
+	return best
+}
+
+// locusHit scores the matches of a marker at one locus of a contig.
+func (m *markerIndex) locusHit(marker MarkerRecord, found []markerMatch) markerHit {
+	matched := map[int]bool{}
+	start, end := found[0].contigPos, 0
+	for _, f := range found {
+		matched[f.markerPos] = true
+		if f.contigPos+m.k > end {
+			end = f.contigPos + m.k
+		}
+	}
+
+	positions := make([]int, 0, len(matched))
+	for pos := range matched {
+		positions = append(positions, pos)
+	}
+	sort.Ints(positions)
+
+	// Gaps shorter than k between shared k-mers are substitutions and
+	// count as covered.
+	var covered, coveredEnd int
+	for i, pos := range positions {
+		from := pos
+		if i > 0 && from-coveredEnd < m.k {
+			from = coveredEnd
+		}
+		if pos+m.k > from {
+			covered += pos + m.k - from
+			coveredEnd = pos + m.k
+		}
+	}
+	span := positions[len(positions)-1] - positions[0] + 1
+
+	return markerHit{
+		Name:     marker.Name,
+		Group:    marker.Group,
+		Start:    start,
+		End:      end,
+		Coverage: float64(covered) / float64(marker.Length),
+		Identity: math.Pow(float64(len(positions))/float64(span), 1/float64(m.k)),
+	}
+}
+
+// overlapsHalf reports whether two hits overlap by at least half of the
+// shorter one.
+func overlapsHalf(a markerHit, b markerHit) bool {
+	overlap := a.End
+	if b.End < overlap {
+		overlap = b.End
+	}
+	if a.Start > b.Start {
+		overlap -= a.Start
+	} else {
+		overlap -= b.Start
+	}
+	shorter := a.End - a.Start
+	if b.End-b.Start < shorter {
+		shorter = b.End - b.Start
+	}
+	return 2*overlap >= shorter
+}
+
+// formatMarkerHits formats hits for a delimited result column as
+// name[coverage,identity] joined with ";".
+func formatMarkerHits(hits []markerHit) string {
+	var fields []string
+	for _, hit := range hits {
+		fields = append(fields, fmt.Sprintf("%s[%.2f,%.3f]", hit.Name, hit.Coverage, hit.Identity))
+	}
+	return strings.Join(fields, ";")
+}
+
+// markerGroups lists the distinct groups of hits in order.
+func markerGroups(hits []markerHit) []string {
+	var groups []string
+	for _, hit := range hits {
+		if !containsString(groups, hit.Group) {
+			groups = append(groups, hit.Group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func makeMarkerDB(t *testing.T, fsys *memFS, markers []fastaRecord) {
+	t.Helper()
+	fsys.write("markers.fna", formatFasta(markers))
+	opts := &markerdbOptions{In: "markers.fna", Out: "markers.phm", Kmer: 18, FS: fsys}
+	if err := runMarkerdb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("markerdb: %v", err)
+	}
+}
+
+func TestMarkerScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	incF := randomSequence(rng, 600)
+	incX := randomSequence(rng, 600)
+
+	fsys := newMemFS()
+	makeMarkerDB(t, fsys, []fastaRecord{
+		{id: "IncFII_1_TEST01", seq: incF},
+		{id: "IncFII_2_TEST02", seq: mutate(rng, incF, 0.02)},
+		{id: "IncX1_1_TEST03", seq: incX},
+		{id: "IncN_1_TEST04", seq: randomSequence(rng, 600)},
+	})
+	index, info, err := loadMarkers(fsys, "markers.phm", 0.6, 0.9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Kmer != 18 || info.Markers != 4 {
+		t.Errorf("got %+v, want k=18 and 4 markers", info)
+	}
+
+	// IncFII in reverse complement with one substitution, and only the
+	// first third of IncX1.
+	variant := []byte(incF)
+	variant[300] = map[byte]byte{'A': 'C', 'C': 'G', 'G': 'T', 'T': 'A'}[variant[300]]
+	contig := randomSequence(rng, 2000) + reverseComplement(string(variant)) + randomSequence(rng, 1000) + incX[:200] + randomSequence(rng, 1000)
+
+	hits := index.scan(letters(contig))
+	if len(hits) != 1 {
+		t.Fatalf("got %+v, want one hit", hits)
+	}
+	hit := hits[0]
+	if hit.Name != "IncFII_1_TEST01" || hit.Group != "IncFII" || hit.Start != 2000 || hit.End != 2600 {
+		t.Errorf("got %+v, want IncFII_1_TEST01 at 2000-2600", hit)
+	}
+	if hit.Coverage != 1 || hit.Identity < 0.995 || hit.Identity >= 1 {
+		t.Errorf("coverage %v and identity %v, want 1 and about 0.998", hit.Coverage, hit.Identity)
+	}
+}
+
+func TestMarkerScanDuplicated(t *testing.T) {
+	rng := rand.New(rand.NewSource(40))
+	gene := randomSequence(rng, 800)
+
+	fsys := newMemFS()
+	makeMarkerDB(t, fsys, []fastaRecord{{id: "blaTEM-1_1_TEST01", seq: gene}})
+	index, _, err := loadMarkers(fsys, "markers.phm", 0.6, 0.9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two copies of the gene, as when carried by two IS elements, the second
+	// in reverse complement.
+	contig := randomSequence(rng, 1000) + gene + randomSequence(rng, 3000) + reverseComplement(gene) + randomSequence(rng, 500)
+	hits := index.scan(letters(contig))
+	want := [][2]int{{1000, 1800}, {4800, 5600}}
+	if len(hits) != len(want) {
+		t.Fatalf("got %+v, want a hit for each copy", hits)
+	}
+	for i, hit := range hits {
+		if hit.Start != want[i][0] || hit.End != want[i][1] || hit.Coverage != 1 || hit.Identity != 1 {
+			t.Errorf("hit %d is %+v, want %d-%d with coverage and identity 1", i, hit, want[i][0], want[i][1])
+		}
+	}
+}
+
+func TestIdentifyReplicons(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	queries := mustParseFasta(t, fsys.read(t, testQueries))
+	makeMarkerDB(t, fsys, []fastaRecord{
+		{id: "IncI1_1_TEST01", seq: queries["exact"][1000:1600]},
+		{id: "IncX1_1_TEST02", seq: queries["unrelated"][1000:1600]},
+	})
+
+	opts := defaultIdentifyOptions(fsys)
+	opts.Format = "jsonl"
+	opts.Replicons = "markers.phm"
+	opts.MarkerCov = 60
+	opts.MarkerID = 90
+	if err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	results := fsys.results(t, "out/pHash.jsonl")
+	want := []markerHit{{Name: "IncI1_1_TEST01", Group: "IncI1", Start: 1000, End: 1600, Coverage: 1, Identity: 1}}
+	if got := results["exact"].Replicons; !reflect.DeepEqual(got, want) {
+		t.Errorf("exact contig is typed %+v, want %+v", got, want)
+	}
+	// Contigs without a plasmid hit are not typed.
+	for _, contig := range []string{"fragment", "mutated", "chimera", "unrelated"} {
+		if got := results[contig].Replicons; len(got) != 0 {
+			t.Errorf("%s is typed %+v, want none", contig, got)
+		}
+	}
+	if !strings.Contains(fsys.read(t, "out/pHash_plasmids.fna"), "replicons=IncI1") {
+		t.Error("plasmid FASTA description has no replicons")
+	}
+	if !strings.Contains(fsys.read(t, "out/pHash_report.html"), ">IncI1</span>") {
+		t.Error("report has no replicons")
+	}
+}
+
+func TestIdentifyResistance(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	rng := rand.New(rand.NewSource(7))
+	queries := mustParseFasta(t, fsys.read(t, testQueries))
+	makeMarkerDB(t, fsys, []fastaRecord{
+		{id: "blaTEM-1B_1_TEST01", seq: mutate(rng, queries["mutated"][2000:2800], 0.01)},
+		{id: "sul2_1_TEST02", seq: queries["fragment"][100:900]},
+		{id: "tet(A)_1_TEST03", seq: queries["unrelated"][100:900]},
+	})
+
+	opts := defaultIdentifyOptions(fsys)
+	opts.Format = "jsonl"
+	opts.Resistance = "markers.phm"
+	opts.MarkerCov = 60
+	opts.MarkerID = 90
+	if err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	records, err := readResults(strings.NewReader(fsys.read(t, "out/pHash.jsonl")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	genes := map[string][]markerHit{}
+	for _, record := range records {
+		genes[record.ContigID] = record.Resistance
+	}
+	if hits := genes["mutated"]; len(hits) != 1 || hits[0].Group != "blaTEM-1B" || hits[0].Identity > 0.995 || hits[0].Identity < 0.97 {
+		t.Errorf("mutated has %+v, want blaTEM-1B at about 98%% identity", hits)
+	}
+	if hits := genes["fragment"]; len(hits) != 1 || hits[0].Group != "sul2" || hits[0].Start != 100 || hits[0].End != 900 {
+		t.Errorf("fragment has %+v, want sul2 at 100-900", hits)
+	}
+	if hits := genes["unrelated"]; len(hits) != 0 {
+		t.Errorf("unrelated has %+v, want none", hits)
+	}
+
+	report := fsys.read(t, "out/pHash_report.html")
+	if !strings.Contains(report, "Resistance genes") || !strings.Contains(report, ">sul2</span>") {
+		t.Error("report has no resistance genes")
+	}
+}
+
+// mustParseFasta maps the names of a FASTA file to their sequences.
+func mustParseFasta(t *testing.T, text string) map[string]string {
+	t.Helper()
+	seqs := map[string]string{}
+	var name string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, ">") {
+			name = strings.Fields(line[1:])[0]
+			continue
+		}
+		seqs[name] += line
+	}
+	return seqs
+}
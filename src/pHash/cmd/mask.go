@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/OneOfOne/xxhash"
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+)
+
+// kmerMask holds the canonical k-mers left out of sketches, such as those of
+// transposons and integrons shared by unrelated plasmids, by their 64-bit
+// xxhash. A nil mask leaves out nothing.
+type kmerMask map[uint64]struct{}
+
+func newKmerMask(keys []uint64) kmerMask {
+	if len(keys) == 0 {
+		return nil
+	}
+	mask := make(kmerMask, len(keys))
+	for _, key := range keys {
+		mask[key] = struct{}{}
+	}
+	return mask
+}
+
+func (m kmerMask) contains(kmer string) bool {
+	if len(m) == 0 {
+		return false
+	}
+	_, ok := m[xxhash.ChecksumString64(kmer)]
+	return ok
+}
+
+// keys lists the k-mers of the mask in order, as stored in databases, or nil
+// for an empty mask so that the field is left out.
+func (m kmerMask) keys() []uint64 {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]uint64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// maskDigest identifies the mask of a database in sketch files, empty
+// without a mask.
+func maskDigest(keys []uint64) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	var b [8]byte
+	for _, key := range keys {
+		binary.LittleEndian.PutUint64(b[:], key)
+		h.Write(b[:])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// kmerKeys calls fn with the keys of the distinct canonical k-mers of every
+// sequence of a FASTA file.
+func kmerKeys(ctx context.Context, fsys fileSystem, name string, k int, hasher Hasher, fn func(keys []uint64)) (int, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	in := fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
+
+	mutex := new(sync.Mutex)
+	sequences := 0
+	err = readSequences(ctx, in, 64, func(i int, s seq.Sequence) error {
+		kmers := canonicalKmers(s.Slice(), k, hasher, nil)
+		keys := make([]uint64, 0, len(kmers))
+		for kmer := range kmers {
+			keys = append(keys, xxhash.ChecksumString64(kmer))
+		}
+		mutex.Lock()
+		fn(keys)
+		sequences++
+		mutex.Unlock()
+		return nil
+	})
+	return sequences, err
+}
+
+// addFastaMask adds the k-mers of the sequences of a FASTA file to mask.
+func addFastaMask(ctx context.Context, fsys fileSystem, name string, k int, hasher Hasher, mask kmerMask) error {
+	_, err := kmerKeys(ctx, fsys, name, k, hasher, func(keys []uint64) {
+		for _, key := range keys {
+			mask[key] = struct{}{}
+		}
+	})
+	return err
+}
+
+// addFrequentKmers adds to mask the k-mers found in more than the given
+// fraction of the sequences of a FASTA file, and returns how many it added.
+func addFrequentKmers(ctx context.Context, fsys fileSystem, name string, k int, hasher Hasher, fraction float64, mask kmerMask) (int, error) {
+	counts := map[uint64]uint32{}
+	sequences, err := kmerKeys(ctx, fsys, name, k, hasher, func(keys []uint64) {
+		for _, key := range keys {
+			counts[key]++
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	added := 0
+	limit := fraction * float64(sequences)
+	for key, count := range counts {
+		if float64(count) > limit {
+			if _, ok := mask[key]; !ok {
+				added++
+			}
+			mask[key] = struct{}{}
+		}
+	}
+	return added, nil
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/OneOfOne/xxhash"
+)
+
+// newMaskFS writes four references of which three carry the same insertion
+// sequence, a FASTA file of the insertion sequence and two queries: a copy
+// of the first reference and the insertion sequence in random flanks.
+func newMaskFS() *memFS {
+	rng := rand.New(rand.NewSource(49))
+	is := randomSequence(rng, 1500)
+	refs := []fastaRecord{
+		{id: "A", seq: randomSequence(rng, 4000) + is},
+		{id: "B", seq: randomSequence(rng, 4000) + is},
+		{id: "C", seq: is + randomSequence(rng, 4000)},
+		{id: "D", seq: randomSequence(rng, 5500)},
+	}
+
+	fsys := newMemFS()
+	fsys.write("refs.fna", formatFasta(refs))
+	fsys.write("is.fna", formatFasta([]fastaRecord{{id: "IS1", seq: is}}))
+	fsys.write(testQueries, formatFasta([]fastaRecord{
+		{id: "copy", seq: refs[0].seq},
+		{id: "transposon", seq: randomSequence(rng, 1000) + reverseComplement(is) + randomSequence(rng, 1000)},
+	}))
+	return fsys
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(opts *makedbOptions)
+		masked bool
+	}{
+		{name: "none", modify: func(opts *makedbOptions) {}},
+		{name: "FASTA", modify: func(opts *makedbOptions) { opts.Mask = "is.fna" }, masked: true},
+		{name: "frequency cap", modify: func(opts *makedbOptions) { opts.MaxKmerFreq = 50 }, masked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newMaskFS()
+			opts := &makedbOptions{In: "refs.fna", Out: testDB, Kmer: 16, Sketch: 128, FS: fsys}
+			tt.modify(opts)
+			if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("makedb: %v", err)
+			}
+			binary := []byte(fsys.read(t, testDB))
+			plasmids, err := messagePackDecoding(&binary)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if masked := len(plasmids.Mask) > 0; masked != tt.masked {
+				t.Fatalf("database masks %d k-mers", len(plasmids.Mask))
+			}
+			if tt.masked && (len(plasmids.Mask) < 1400 || len(plasmids.Mask) > 1500) {
+				t.Errorf("database masks %d k-mers, want those of the insertion sequence", len(plasmids.Mask))
+			}
+
+			if err := runIdentify(context.Background(), defaultIdentifyOptions(fsys), ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("identify: %v", err)
+			}
+			results := fsys.results(t, "out/pHash.tsv")
+			if copied := results["copy"]; copied.HitAccID != "A" || copied.Similarity != 1 || !copied.PassThreshold {
+				t.Errorf("the copy of A is identified as %+v", copied)
+			}
+			if transposon := results["transposon"]; transposon.PassThreshold == tt.masked {
+				t.Errorf("the insertion sequence identified: %v, want %v (%+v)", transposon.PassThreshold, !tt.masked, transposon)
+			}
+		})
+	}
+}
+
+func TestMaskSketchFile(t *testing.T) {
+	fsys := newMaskFS()
+	opts := &makedbOptions{In: "refs.fna", Out: testDB, Kmer: 16, Sketch: 128, Mask: "is.fna", FS: fsys}
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+
+	unmasked := &sketchOptions{In: testQueries, Out: "unmasked.phs", Kmer: 16, Sketch: 128, Hash: "xxhash64", FS: fsys}
+	masked := &sketchOptions{In: testQueries, Out: "masked.phs", DB: testDB, FS: fsys}
+	for _, sketch := range []*sketchOptions{unmasked, masked} {
+		if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+			t.Fatalf("sketch: %v", err)
+		}
+	}
+
+	identify := defaultIdentifyOptions(fsys)
+	identify.In = "unmasked.phs"
+	err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "unmasked.phs was not sketched with the mask of the database") {
+		t.Errorf("got error %v for sketches without the mask", err)
+	}
+
+	identify.In = "masked.phs"
+	if err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+	binary := []byte(fsys.read(t, testDB))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := strings.SplitN(fsys.read(t, "out/pHash.tsv"), "\n", 3)[1]
+	if want := fmt.Sprintf("# database: %s (k=16, sketch=128, plasmids=4, masked k-mers=%d)", testDB, len(plasmids.Mask)); header != want {
+		t.Errorf("result header %q, want %q", header, want)
+	}
+}
+
+func TestAddFrequentKmers(t *testing.T) {
+	fsys := newMaskFS()
+	is := mustParseFasta(t, fsys.read(t, "is.fna"))["IS1"]
+	want := kmerMask{}
+	for kmer := range canonicalKmers(letters(is), 16, defaultHasher, nil) {
+		want[xxhash.ChecksumString64(kmer)] = struct{}{}
+	}
+
+	// The insertion sequence is in 3 of the 4 references.
+	for _, fraction := range []float64{0.5, 0.75} {
+		mask := kmerMask{}
+		added, err := addFrequentKmers(context.Background(), fsys, "refs.fna", 16, defaultHasher, fraction, mask)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fraction == 0.75 {
+			if added != 0 || len(mask) != 0 {
+				t.Errorf("fraction 0.75 masks %d k-mers, want none", added)
+			}
+			continue
+		}
+		if added != len(want) || !reflect.DeepEqual(mask, want) {
+			t.Errorf("fraction 0.5 masks %d k-mers, want the %d of the insertion sequence", added, len(want))
+		}
+	}
+
+	// K-mers already masked are not counted again.
+	mask := kmerMask{}
+	for key := range want {
+		mask[key] = struct{}{}
+		break
+	}
+	added, err := addFrequentKmers(context.Background(), fsys, "refs.fna", 16, defaultHasher, 0.5, mask)
+	if err != nil || added != len(want)-1 || len(mask) != len(want) {
+		t.Errorf("added %d k-mers to a mask holding one of them, want %d (%v)", added, len(want)-1, err)
+	}
+}
@@ -0,0 +1,390 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// Result files written by identify share one schema across formats. Column
+// order is stable; new columns are only ever appended at the end.
+//
+//	contig_id       query contig name
+//	contig_length   contig length in bp
+//	query_kmers     distinct canonical k-mers in the contig
+//	hit_accession   best hit accession (empty if no sketch value is shared)
+//	hit_phylum      phylum of the best hit from the makedb metadata
+//	similarity      estimated Jaccard similarity to the best hit
+//	shared_hashes   sketch values shared with the best hit
+//	sketch_size     sketch size of the database
+//	pass_threshold  whether similarity reaches --threshold
+//	coverage        read coverage from the contig header (with --coverage)
+//	copy_number     coverage relative to the chromosomal baseline
+//	chromosome_hit  best chromosome chunk (with chromosome sketches)
+//	chromosome_similarity
+//	                estimated Jaccard similarity to the best chromosome chunk
+//	margin          similarity minus chromosome_similarity
+//	class           plasmid, chromosome, ambiguous or unclassified
+//	replicons       replicon markers of identified contigs (with --replicons)
+//	resistance_genes
+//	                resistance genes of identified contigs (with --resistance)
+//	skipped         reason the contig was not identified, empty otherwise
+//
+// Contigs with tied best hits are reported on one line per hit, and skipped
+// contigs on one line with no hit.
+var resultColumns = []string{
+	"contig_id",
+	"contig_length",
+	"query_kmers",
+	"hit_accession",
+	"hit_phylum",
+	"similarity",
+	"shared_hashes",
+	"sketch_size",
+	"pass_threshold",
+	"coverage",
+	"copy_number",
+	"chromosome_hit",
+	"chromosome_similarity",
+	"margin",
+	"class",
+	"replicons",
+	"resistance_genes",
+	"skipped",
+}
+
+type (
+	hitRecord struct {
+		ContigID      string   `json:"contig_id"`
+		ContigLength  int      `json:"contig_length"`
+		QueryKmers    int      `json:"query_kmers"`
+		HitAccID      string   `json:"hit_accession"`
+		HitPhylum     string   `json:"hit_phylum"`
+		Similarity    float32  `json:"similarity"`
+		SharedHashes  int      `json:"shared_hashes"`
+		SketchSize    uint64   `json:"sketch_size"`
+		PassThreshold bool     `json:"pass_threshold"`
+		Coverage      *float64 `json:"coverage"`
+		CopyNumber    *float64 `json:"copy_number"`
+
+		// The chromosome columns are empty unless the database has
+		// chromosome sketches; Class is empty then.
+		ChromosomeHit        string  `json:"chromosome_hit"`
+		ChromosomeSimilarity float32 `json:"chromosome_similarity"`
+		Margin               float32 `json:"margin"`
+		Class                string  `json:"class"`
+
+		Replicons  []markerHit `json:"replicons"`
+		Resistance []markerHit `json:"resistance_genes"`
+		Skipped    string      `json:"skipped"`
+	}
+
+	runInfo struct {
+		Version    string  `json:"version"`
+		Database   string  `json:"database"`
+		Kmer       int     `json:"kmer"`
+		SketchSize uint64  `json:"sketch_size"`
+		Plasmids   int     `json:"plasmids"`
+		Hash       string  `json:"hash,omitempty"`   // empty for xxhash64
+		Scheme     string  `json:"scheme,omitempty"` // empty for kmins
+		Masked     int     `json:"masked_kmers,omitempty"`
+		Threshold  float64 `json:"threshold"`
+		Window     int     `json:"window"`
+		Step       int     `json:"step"`
+		MinLength  int     `json:"min_length,omitempty"`
+		MinKmers   int     `json:"min_kmers,omitempty"`
+
+		// BaselineCoverage is the chromosomal coverage copy numbers are
+		// relative to, 0 unless identify runs with --coverage.
+		BaselineCoverage float64 `json:"baseline_coverage,omitempty"`
+
+		// Chromosomes is the number of chromosome chunk sketches in the
+		// database and Margin the similarity margin used to label contigs.
+		Chromosomes int     `json:"chromosomes,omitempty"`
+		Margin      float64 `json:"margin,omitempty"`
+
+		// Replicons and Resistance are the marker databases of replicons and
+		// resistance genes, searched for hits with at least MarkerCoverage and
+		// MarkerIdentity.
+		Replicons      *markerDBInfo `json:"replicons,omitempty"`
+		Resistance     *markerDBInfo `json:"resistance,omitempty"`
+		MarkerCoverage float64       `json:"marker_coverage,omitempty"`
+		MarkerIdentity float64       `json:"marker_identity,omitempty"`
+	}
+
+	resultWriter interface {
+		WriteHeader(run runInfo) error
+		Write(record hitRecord) error
+		Flush() error
+	}
+
+	delimitedWriter struct {
+		w   io.Writer
+		csv *csv.Writer
+	}
+
+	jsonLinesWriter struct {
+		enc *json.Encoder
+	}
+)
+
+var resultFormats = []string{"tsv", "csv", "jsonl"}
+
+func newResultWriter(w io.Writer, format string) (resultWriter, error) {
+	switch format {
+	case "tsv":
+		cw := csv.NewWriter(w)
+		cw.Comma = '\t'
+		return &delimitedWriter{w: w, csv: cw}, nil
+	case "csv":
+		return &delimitedWriter{w: w, csv: csv.NewWriter(w)}, nil
+	case "jsonl":
+		return &jsonLinesWriter{enc: json.NewEncoder(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (choose from %v)", format, resultFormats)
+}
+
+func (r runInfo) comments() []string {
+	details := fmt.Sprintf("k=%d, sketch=%d, plasmids=%d", r.Kmer, r.SketchSize, r.Plasmids)
+	parameters := fmt.Sprintf("parameters: threshold=%g window=%d step=%d", r.Threshold, r.Window, r.Step)
+	if r.Chromosomes > 0 {
+		details += fmt.Sprintf(", chromosome chunks=%d", r.Chromosomes)
+		parameters += fmt.Sprintf(" margin=%g", r.Margin)
+	}
+	if r.Hash != "" {
+		details += ", hash=" + r.Hash
+	}
+	if r.Scheme != "" {
+		details += ", scheme=" + r.Scheme
+	}
+	if r.Masked > 0 {
+		details += fmt.Sprintf(", masked k-mers=%d", r.Masked)
+	}
+	database := fmt.Sprintf("database: %s (%s)", r.Database, details)
+	if r.MinLength > 0 || r.MinKmers > 0 {
+		parameters += fmt.Sprintf(" min_length=%d min_kmers=%d", r.MinLength, r.MinKmers)
+	}
+	if r.Replicons != nil || r.Resistance != nil {
+		parameters += fmt.Sprintf(" marker_coverage=%g marker_identity=%g", r.MarkerCoverage, r.MarkerIdentity)
+	}
+	comments := []string{
+		fmt.Sprintf("pHash %s", r.Version),
+		database,
+		parameters,
+	}
+	if r.Replicons != nil {
+		comments = append(comments, fmt.Sprintf("replicon markers: %s (k=%d, markers=%d)", r.Replicons.Path, r.Replicons.Kmer, r.Replicons.Markers))
+	}
+	if r.Resistance != nil {
+		comments = append(comments, fmt.Sprintf("resistance markers: %s (k=%d, markers=%d)", r.Resistance.Path, r.Resistance.Kmer, r.Resistance.Markers))
+	}
+	if r.BaselineCoverage > 0 {
+		comments = append(comments, fmt.Sprintf("baseline coverage: %g", r.BaselineCoverage))
+	}
+	return comments
+}
+
+func formatOptionalFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', 2, 64)
+}
+
+// formatChromosomeFields formats the chromosome columns, which are empty
+// unless the contig has been classified.
+func formatChromosomeFields(record hitRecord) []string {
+	if record.Class == "" {
+		return []string{"", "", "", ""}
+	}
+	return []string{
+		record.ChromosomeHit,
+		strconv.FormatFloat(float64(record.ChromosomeSimilarity), 'f', 6, 32),
+		strconv.FormatFloat(float64(record.Margin), 'f', 6, 32),
+		record.Class,
+	}
+}
+
+func parseOptionalFloat(s string) *float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func (d *delimitedWriter) WriteHeader(run runInfo) error {
+	for _, comment := range run.comments() {
+		if _, err := fmt.Fprintf(d.w, "# %s\n", comment); err != nil {
+			return err
+		}
+	}
+	return d.csv.Write(resultColumns)
+}
+
+func (d *delimitedWriter) Write(record hitRecord) error {
+	return d.csv.Write(append([]string{
+		record.ContigID,
+		strconv.Itoa(record.ContigLength),
+		strconv.Itoa(record.QueryKmers),
+		record.HitAccID,
+		record.HitPhylum,
+		strconv.FormatFloat(float64(record.Similarity), 'f', 6, 32),
+		strconv.Itoa(record.SharedHashes),
+		strconv.FormatUint(record.SketchSize, 10),
+		strconv.FormatBool(record.PassThreshold),
+		formatOptionalFloat(record.Coverage),
+		formatOptionalFloat(record.CopyNumber),
+	}, append(formatChromosomeFields(record), formatMarkerHits(record.Replicons), formatMarkerHits(record.Resistance), record.Skipped)...))
+}
+
+func (d *delimitedWriter) Flush() error {
+	d.csv.Flush()
+	return d.csv.Error()
+}
+
+// The first JSON Lines record carries the run information under "run"; every
+// following line is a hit record.
+func (j *jsonLinesWriter) WriteHeader(run runInfo) error {
+	return j.enc.Encode(struct {
+		Run runInfo `json:"run"`
+	}{run})
+}
+
+func (j *jsonLinesWriter) Write(record hitRecord) error {
+	return j.enc.Encode(record)
+}
+
+func (j *jsonLinesWriter) Flush() error {
+	return nil
+}
+
+// resultColumnAliases maps the column names of older result tables, such as
+// the supplementary tables of the paper, to the current schema.
+var resultColumnAliases = map[string]string{
+	"ContigID":   "contig_id",
+	"BestHit":    "hit_accession",
+	"Similarity": "similarity",
+}
+
+// readResults reads hit records written in any of the result formats. It
+// also accepts older tables with only some of the columns, where ties are
+// joined with ":" and similarities are written as "(0.869806)".
+func readResults(r io.Reader) ([]hitRecord, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var first string
+	for _, line := range strings.Split(string(b), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			first = line
+			break
+		}
+	}
+	if first == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(first, "{") {
+		return readJSONLinesResults(b)
+	}
+
+	cr := csv.NewReader(bytes.NewReader(b))
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	if strings.Contains(first, "\t") {
+		cr.Comma = '\t'
+	}
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	index := map[string]int{}
+	for i, name := range header {
+		if alias, ok := resultColumnAliases[name]; ok {
+			name = alias
+		}
+		index[name] = i
+	}
+	for _, required := range []string{"contig_id", "hit_accession", "similarity"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("result table has no %s column", required)
+		}
+	}
+
+	var records []hitRecord
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		}
+
+		record := hitRecord{ContigID: field("contig_id"), HitPhylum: field("hit_phylum")}
+		similarity, err := strconv.ParseFloat(strings.Trim(field("similarity"), "() "), 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid similarity %q", record.ContigID, field("similarity"))
+		}
+		record.Similarity = float32(similarity)
+		record.ContigLength, _ = strconv.Atoi(field("contig_length"))
+		record.QueryKmers, _ = strconv.Atoi(field("query_kmers"))
+		record.SharedHashes, _ = strconv.Atoi(field("shared_hashes"))
+		record.SketchSize, _ = strconv.ParseUint(field("sketch_size"), 10, 64)
+		record.PassThreshold, _ = strconv.ParseBool(field("pass_threshold"))
+		record.Coverage = parseOptionalFloat(field("coverage"))
+		record.CopyNumber = parseOptionalFloat(field("copy_number"))
+		record.ChromosomeHit = field("chromosome_hit")
+		record.Class = field("class")
+		record.Skipped = field("skipped")
+		if f := parseOptionalFloat(field("chromosome_similarity")); f != nil {
+			record.ChromosomeSimilarity = float32(*f)
+		}
+		if f := parseOptionalFloat(field("margin")); f != nil {
+			record.Margin = float32(*f)
+		}
+
+		for _, acc := range strings.Split(field("hit_accession"), ":") {
+			record.HitAccID = acc
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+func readJSONLinesResults(b []byte) ([]hitRecord, error) {
+	var records []hitRecord
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var line struct {
+			hitRecord
+			Run *runInfo `json:"run"`
+		}
+		err := dec.Decode(&line)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line.Run == nil {
+			records = append(records, line.hitRecord)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const histogramBins = 20
+
+const defaultLinkPattern = "https://www.ncbi.nlm.nih.gov/nuccore/{acc}"
+
+// now is the clock of the report timestamp, fixed by tests.
+var now = time.Now
+
+// The types below are the data model passed to report templates, either the
+// built-in one or a file given with identify --template. The root value is a
+// reportData; field names are part of the template interface and are kept
+// stable.
+type (
+	// reportHit is a reference plasmid tied for the best hit of a contig.
+	reportHit struct {
+		AccID  string // accession of the reference plasmid
+		Phylum string // phylum from the makedb metadata
+		URL    string // link built from --link, empty if disabled
+	}
+
+	// reportRow is a contig passing the threshold.
+	reportRow struct {
+		AccID      string      // contig name
+		Seq        string      // first and last bases of the contig
+		Length     int         // contig length in bp
+		Hits       []reportHit // best hits
+		Phylum     string      // comma separated phyla of the best hits
+		Jaccard    float32     // similarity to the best hits
+		Coverage   float64     // coverage from the contig header, 0 if unknown
+		CopyNumber float64     // Coverage relative to Run.BaselineCoverage
+		Class      string      // plasmid or ambiguous with chromosome sketches
+		Replicons  []markerHit // replicon markers found with --replicons
+		Resistance []markerHit // resistance genes found with --resistance
+	}
+
+	// databaseInfo describes the database file used for the run.
+	databaseInfo struct {
+		Path     string
+		Size     int64
+		Modified string // RFC 3339
+		SHA256   string // hex digest of the file
+	}
+
+	// phylumSummary counts identified contigs per phylum, largest first.
+	phylumSummary struct {
+		Phylum  string
+		Contigs int
+		Bases   int
+		Percent float64 // Contigs relative to the largest phylum
+	}
+
+	// histogramBin counts contigs by best-hit similarity in [Lower, Upper).
+	histogramBin struct {
+		Lower   float64
+		Upper   float64
+		Count   int
+		Percent float64 // Count relative to the largest bin
+		Pass    bool    // bin lies above the threshold
+	}
+
+	// reportAssets are the built-in assets, ready to be inlined.
+	reportAssets struct {
+		CSS    template.CSS // bootstrap stylesheet
+		Logo   template.URL // pHash logo as a data URL
+		Script template.JS  // table sorting and filtering
+	}
+
+	// plasmidCandidate is a best hit of contigs with known coverage.
+	plasmidCandidate struct {
+		AccID      string
+		Phylum     string
+		Contigs    int
+		Bases      int
+		Coverage   float64 // length-weighted mean coverage of the contigs
+		CopyNumber float64 // Coverage relative to Run.BaselineCoverage
+	}
+
+	reportData struct {
+		Run        runInfo // version, database summary and parameters
+		Database   databaseInfo
+		Input      string        // query FASTA file
+		Generated  string        // RFC 3339
+		Contigs    int           // number of query contigs
+		Skipped    []skipSummary // contigs not identified, by reason
+		Rows       []reportRow
+		Phyla      []phylumSummary
+		Histogram  []histogramBin
+		Candidates []plasmidCandidate // best hits by copy number, with --coverage
+		Assets     reportAssets
+	}
+)
+
+func hitURL(pattern string, accID string) string {
+	if pattern == "" {
+		return ""
+	}
+	return strings.Replace(pattern, "{acc}", url.PathEscape(accID), -1)
+}
+
+func newDatabaseInfo(fsys fileSystem, path string, binary []byte) databaseInfo {
+	info := databaseInfo{
+		Path:   path,
+		Size:   int64(len(binary)),
+		SHA256: fmt.Sprintf("%x", sha256.Sum256(binary)),
+	}
+	if stat, err := fsys.Stat(path); err == nil {
+		info.Modified = stat.ModTime().Format(time.RFC3339)
+	}
+	return info
+}
+
+func summarizePhyla(rows []reportRow) []phylumSummary {
+	index := map[string]int{}
+	var phyla []phylumSummary
+	for _, row := range rows {
+		i, ok := index[row.Phylum]
+		if !ok {
+			i = len(phyla)
+			index[row.Phylum] = i
+			phyla = append(phyla, phylumSummary{Phylum: row.Phylum})
+		}
+		phyla[i].Contigs++
+		phyla[i].Bases += row.Length
+	}
+
+	sort.Slice(phyla, func(i, j int) bool {
+		if phyla[i].Contigs != phyla[j].Contigs {
+			return phyla[i].Contigs > phyla[j].Contigs
+		}
+		return phyla[i].Phylum < phyla[j].Phylum
+	})
+	for i := range phyla {
+		phyla[i].Percent = 100 * float64(phyla[i].Contigs) / float64(phyla[0].Contigs)
+	}
+
+	return phyla
+}
+
+func similarityHistogram(similarities []float32, threshold float64) []histogramBin {
+	bins := make([]histogramBin, histogramBins)
+	for i := range bins {
+		bins[i].Lower = float64(i) / histogramBins
+		bins[i].Upper = float64(i+1) / histogramBins
+		bins[i].Pass = bins[i].Upper > threshold
+	}
+
+	var max int
+	for _, similarity := range similarities {
+		i := int(float64(similarity) * histogramBins)
+		if i < 0 {
+			i = 0
+		} else if i >= histogramBins {
+			i = histogramBins - 1
+		}
+		bins[i].Count++
+		if bins[i].Count > max {
+			max = bins[i].Count
+		}
+	}
+	for i := range bins {
+		if max > 0 {
+			bins[i].Percent = 100 * float64(bins[i].Count) / float64(max)
+		}
+	}
+
+	return bins
+}
+
+func readAsset(name string) ([]byte, error) {
+	f, err := Assets.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ioutil.ReadAll(f)
+}
+
+func loadReportAssets() (reportAssets, error) {
+	css, err := readAsset("/assets/assets/bootstrap.min.css")
+	if err != nil {
+		return reportAssets{}, err
+	}
+	logo, err := readAsset("/assets/assets/pHash_logo.svg")
+	if err != nil {
+		return reportAssets{}, err
+	}
+	script, err := readAsset("/assets/assets/report.js")
+	if err != nil {
+		return reportAssets{}, err
+	}
+
+	return reportAssets{
+		CSS:    template.CSS(css),
+		Logo:   template.URL("data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(logo)),
+		Script: template.JS(script),
+	}, nil
+}
+
+func loadReportTemplate(fsys fileSystem, templateFile string) (*template.Template, error) {
+	var (
+		contents []byte
+		err      error
+	)
+	if templateFile == "" {
+		contents, err = readAsset("/assets/template.html.tpl")
+	} else {
+		contents, err = readFile(fsys, templateFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New("report").Parse(string(contents))
+}
+
+func writeReport(w io.Writer, t *template.Template, data reportData) error {
+	var err error
+	data.Assets, err = loadReportAssets()
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(w, data)
+}
@@ -6,13 +6,15 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	o = &Options{}
+const version = "v0.2"
 
+var (
 	RootCmd = &cobra.Command{
-		Use:   "pHash",
-		Short: "Software to identify knwon plasmid",
-		Long:  "Software to identify knwon plasmid from metagenome using Minhash",
+		Use:           "pHash",
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		Short:         "Software to identify knwon plasmid",
+		Long:          "Software to identify knwon plasmid from metagenome using Minhash",
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.Help()
 		},
@@ -23,7 +25,7 @@ var (
 		Short: "Print the version number of pHash",
 		Long:  "Print the version number of pHash",
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("pHash v0.2")
+			fmt.Println("pHash " + version)
 		},
 	}
 )
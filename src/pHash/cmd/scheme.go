@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/biogo/biogo/alphabet"
+)
+
+// bottomKSeed is the seed of bottom-k sketches, that of Mash and sourmash.
+const bottomKSeed = 42
+
+// sketchScheme builds sketches from the distinct canonical k-mers of a
+// sequence and compares them. Compare returns the number of hashes two
+// sketches share and the number they are estimated from.
+type sketchScheme interface {
+	Name() string
+	Sketch(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, int)
+	Compare(a []uint64, b []uint64, sketchSize uint64) (int, int)
+}
+
+type (
+	// kminsScheme is the scheme of pHash from the start: value i of a
+	// sketch is the minimum hash of the k-mers under seed i.
+	kminsScheme struct{}
+
+	// bottomKScheme keeps the smallest distinct hashes of the k-mers under
+	// bottomKSeed in ascending order, as Mash and sourmash do. Sequences
+	// with fewer k-mers than the sketch size have shorter sketches.
+	bottomKScheme struct{}
+)
+
+// defaultScheme sketches databases without a scheme in their header.
+var defaultScheme sketchScheme = kminsScheme{}
+
+var schemes = []sketchScheme{kminsScheme{}, bottomKScheme{}}
+
+func (kminsScheme) Name() string { return "kmins" }
+
+func (kminsScheme) Sketch(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, int) {
+	return calcMinHash(read, k, sketchSize, hasher, mask)
+}
+
+func (kminsScheme) Compare(a []uint64, b []uint64, sketchSize uint64) (int, int) {
+	return countSharedHashes(a, b, sketchSize), int(sketchSize)
+}
+
+func (bottomKScheme) Name() string { return "bottomk" }
+
+func (bottomKScheme) Sketch(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, int) {
+	return calcBottomK(read, k, sketchSize, hasher, mask)
+}
+
+// Compare walks the union of two sketches in order up to the sketch size,
+// the bottom-k sketch of the union, and counts the hashes found in both, as
+// Mash does.
+func (bottomKScheme) Compare(a []uint64, b []uint64, sketchSize uint64) (int, int) {
+	var shared, compared, i, j int
+	for uint64(compared) < sketchSize && (i < len(a) || j < len(b)) {
+		switch {
+		case j == len(b) || i < len(a) && a[i] < b[j]:
+			i++
+		case i == len(a) || b[j] < a[i]:
+			j++
+		default:
+			shared++
+			i++
+			j++
+		}
+		compared++
+	}
+	return shared, compared
+}
+
+// calcBottomK returns the bottom-k sketch of read and its number of distinct
+// k-mers.
+func calcBottomK(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, int) {
+	kmerMap := canonicalKmers(read, k, hasher, mask)
+
+	hashes := make([]uint64, 0, len(kmerMap))
+	for kmer := range kmerMap {
+		hashes = append(hashes, hasher.Hash([]byte(kmer), bottomKSeed))
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+
+	sketch := make([]uint64, 0, sketchSize)
+	for i, h := range hashes {
+		if uint64(len(sketch)) == sketchSize {
+			break
+		}
+		if i == 0 || h != hashes[i-1] {
+			sketch = append(sketch, h)
+		}
+	}
+	return sketch, len(kmerMap)
+}
+
+// calcBottomKAbundance is calcBottomK with the number of times the k-mer of
+// each value occurs in read.
+func calcBottomKAbundance(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, []uint32, int) {
+	counts := map[string]uint32{}
+	for i := 0; i < read.Len()-(k-1); i++ {
+		if kmer, ok := canonicalKmerAt(read, i, k, hasher); ok && !mask.contains(kmer) {
+			counts[kmer]++
+		}
+	}
+
+	byHash := make(map[uint64]uint32, len(counts))
+	for kmer, n := range counts {
+		byHash[hasher.Hash([]byte(kmer), bottomKSeed)] += n
+	}
+	hashes := make([]uint64, 0, len(byHash))
+	for h := range byHash {
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	if uint64(len(hashes)) > sketchSize {
+		hashes = hashes[:sketchSize]
+	}
+
+	abundances := make([]uint32, len(hashes))
+	for i, h := range hashes {
+		abundances[i] = byHash[h]
+	}
+	return hashes, abundances, len(counts)
+}
+
+// weightedSimilarity is the abundance-weighted Jaccard similarity of two
+// bottom-k sketches: over the bottom-k sketch of their union, the sum of the
+// smaller count of each value over the sum of the larger, where values
+// missing from a sketch count 0. Plasmids with a repeat in several copies
+// are thus told from those with one.
+func weightedSimilarity(a PlasmidRecord, b PlasmidRecord, sketchSize uint64) float32 {
+	var smaller, larger uint64
+	var i, j int
+	for n := uint64(0); n < sketchSize && (i < len(a.PlasmidMinHashValue) || j < len(b.PlasmidMinHashValue)); n++ {
+		switch {
+		case j == len(b.PlasmidMinHashValue) || i < len(a.PlasmidMinHashValue) && a.PlasmidMinHashValue[i] < b.PlasmidMinHashValue[j]:
+			larger += a.abundance(i)
+			i++
+		case i == len(a.PlasmidMinHashValue) || b.PlasmidMinHashValue[j] < a.PlasmidMinHashValue[i]:
+			larger += b.abundance(j)
+			j++
+		default:
+			x, y := a.abundance(i), b.abundance(j)
+			if x > y {
+				x, y = y, x
+			}
+			smaller += x
+			larger += y
+			i++
+			j++
+		}
+	}
+	if larger == 0 {
+		return 0
+	}
+	return float32(float64(smaller) / float64(larger))
+}
+
+// abundance is the count of the k-mer of value i of a record, 1 for records
+// without counts.
+func (r PlasmidRecord) abundance(i int) uint64 {
+	if i >= len(r.Abundances) {
+		return 1
+	}
+	return uint64(r.Abundances[i])
+}
+
+// checkAbundance fails for abundances of a scheme other than bottomk, whose
+// values come from one k-mer each, as in sourmash.
+func checkAbundance(scheme sketchScheme, abundance bool) error {
+	if _, ok := scheme.(bottomKScheme); abundance && !ok {
+		return fmt.Errorf("abundances are only kept by bottomk sketches, not %s (use --scheme bottomk)", scheme.Name())
+	}
+	return nil
+}
+
+// schemeNames lists the names of the sketch schemes.
+func schemeNames() []string {
+	var names []string
+	for _, s := range schemes {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+// lookupScheme returns the scheme of a name in a database header, where the
+// empty name of older databases is kmins.
+func lookupScheme(name string) (sketchScheme, error) {
+	if name == "" {
+		return defaultScheme, nil
+	}
+	for _, s := range schemes {
+		if s.Name() == name {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown sketch scheme %s (choose from %s)", name, strings.Join(schemeNames(), ", "))
+}
+
+// schemeName is the name of the scheme of a database header.
+func schemeName(header string) string {
+	if header == "" {
+		return defaultScheme.Name()
+	}
+	return header
+}
+
+// schemeHeader is the name of a scheme as written in a database header, empty
+// for kmins so that those databases are encoded as before.
+func schemeHeader(s sketchScheme) string {
+	if s.Name() == defaultScheme.Name() {
+		return ""
+	}
+	return s.Name()
+}
@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMurmur3SourmashValue(t *testing.T) {
+	// The value sourmash and Mash give the k-mer ACG under seed 42.
+	if got := (murmur3Hasher{}).Hash([]byte("ACG"), bottomKSeed); got != 1731421407650554201 {
+		t.Errorf("murmur3 of ACG with seed 42 is %d, want 1731421407650554201", got)
+	}
+}
+
+func TestCalcBottomK(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	read := letters(randomSequence(rng, 2000))
+	hasher := murmur3Hasher{}
+
+	sketch, kmers := calcBottomK(read, 16, 128, hasher, nil)
+	if kmers != 1985 || len(sketch) != 128 {
+		t.Fatalf("got %d values of %d k-mers, want 128 of 1985", len(sketch), kmers)
+	}
+	all, _ := calcBottomK(read, 16, 4000, hasher, nil)
+	if len(all) != 1985 || !sort.SliceIsSorted(all, func(i, j int) bool { return all[i] < all[j] }) {
+		t.Fatalf("got %d values of 1985 k-mers, want all of them in ascending order", len(all))
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i] == all[i-1] {
+			t.Fatalf("value %d repeated", all[i])
+		}
+	}
+	if !reflect.DeepEqual(sketch, all[:128]) {
+		t.Error("the sketch is not the smallest 128 hashes of the k-mers")
+	}
+}
+
+func TestBottomKCompare(t *testing.T) {
+	tests := []struct {
+		a, b             []uint64
+		size             uint64
+		shared, compared int
+	}{
+		{[]uint64{1, 2, 3, 4}, []uint64{1, 2, 3, 4}, 4, 4, 4},
+		{[]uint64{1, 3, 5, 7}, []uint64{2, 4, 6, 8}, 4, 0, 4},
+		{[]uint64{1, 2, 3, 4}, []uint64{2, 3, 5, 6}, 4, 2, 4},
+		{[]uint64{1, 2}, []uint64{1, 2, 3, 4}, 4, 2, 4},
+		{[]uint64{1, 2}, []uint64{1, 2}, 4, 2, 2},
+		{nil, []uint64{1, 2}, 4, 0, 2},
+	}
+	for _, tt := range tests {
+		shared, compared := (bottomKScheme{}).Compare(tt.a, tt.b, tt.size)
+		if shared != tt.shared || compared != tt.compared {
+			t.Errorf("Compare(%v, %v, %d) = %d, %d, want %d, %d", tt.a, tt.b, tt.size, shared, compared, tt.shared, tt.compared)
+		}
+	}
+}
+
+func TestLookupScheme(t *testing.T) {
+	for _, name := range []string{"", "kmins"} {
+		if s, err := lookupScheme(name); err != nil || s.Name() != "kmins" || schemeHeader(s) != "" {
+			t.Errorf("lookupScheme(%q) = %v, %v, want kmins", name, s, err)
+		}
+	}
+	if s, err := lookupScheme("bottomk"); err != nil || schemeHeader(s) != "bottomk" {
+		t.Errorf("lookupScheme(bottomk) = %v, %v", s, err)
+	}
+	if _, err := lookupScheme("scaled"); err == nil || err.Error() != "unknown sketch scheme scaled (choose from kmins, bottomk)" {
+		t.Errorf("got error %v for an unknown scheme", err)
+	}
+}
+
+// makeBottomKDB builds the synthetic database with murmur3 bottom-k sketches.
+func makeBottomKDB(t *testing.T, fsys *memFS) {
+	t.Helper()
+	opts := &makedbOptions{In: "refs.fna", Metadata: testRefPhylum, Out: "bottomk.phash", Kmer: 16, Sketch: 128, Hash: "murmur3", Scheme: "bottomk", FS: fsys}
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+}
+
+func TestIdentifyBottomK(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeBottomKDB(t, fsys)
+
+	identify := defaultIdentifyOptions(fsys)
+	identify.DB = "bottomk.phash"
+	if err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+	header := strings.SplitN(fsys.read(t, "out/pHash.tsv"), "\n", 3)[1]
+	if want := "# database: bottomk.phash (k=16, sketch=128, plasmids=4, hash=murmur3, scheme=bottomk)"; header != want {
+		t.Errorf("result header %q, want %q", header, want)
+	}
+	results := fsys.results(t, "out/pHash.tsv")
+	if exact := results["exact"]; exact.HitAccID != "NZ_SYN0001.1" || exact.Similarity != 1 || exact.SharedHashes != 128 || !exact.PassThreshold {
+		t.Errorf("exact copy identified with bottom-k sketches as %+v", exact)
+	}
+	if unrelated := results["unrelated"]; unrelated.HitAccID != "" || unrelated.PassThreshold {
+		t.Errorf("unrelated contig identified with bottom-k sketches as %+v", unrelated)
+	}
+
+	makeSyntheticDB(t, fsys)
+	sketch := &sketchOptions{In: testQueries, Out: "queries.phs", Kmer: 16, Sketch: 128, Hash: "murmur3", Scheme: "kmins", FS: fsys}
+	if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+	identify.In = "queries.phs"
+	identify.Force = true
+	err := runIdentify(context.Background(), identify, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "queries.phs holds kmins sketches, the database bottomk sketches" {
+		t.Errorf("got error %v for sketches of another scheme", err)
+	}
+
+	dist := &distOptions{DB: testDB, DB2: "bottomk.phash", Format: "long", Metric: "jaccard", Out: "dist.tsv", FS: fsys}
+	err = runDist(context.Background(), dist, ioutil.Discard, ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "databases differ in") {
+		t.Errorf("got error %v for databases of different schemes", err)
+	}
+}
+
+func TestExportImportSourmash(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeBottomKDB(t, fsys)
+
+	export := &exportOptions{DB: "bottomk.phash", Out: "plasmids.sig", Meta: "plasmids.csv", FS: fsys}
+	if err := runExport(export, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	var signatures []sourmashSignature
+	if err := json.Unmarshal([]byte(fsys.read(t, "plasmids.sig")), &signatures); err != nil {
+		t.Fatal(err)
+	}
+	for _, sig := range signatures {
+		if mh := sig.Signatures[0]; sig.HashFunction != "0.murmur64" || mh.Seed != 42 || mh.Num != 128 || mh.Molecule != "DNA" {
+			t.Errorf("%s exported as %s with seed %d and num %d, want a sourmash sketch", sig.Name, sig.HashFunction, mh.Seed, mh.Num)
+		}
+	}
+
+	imp := &importOptions{In: "plasmids.sig", Metadata: "plasmids.csv", Out: "imported.phash", FS: fsys}
+	if err := runImport(imp, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if fsys.read(t, "imported.phash") != fsys.read(t, "bottomk.phash") {
+		t.Error("the imported database differs from the exported one")
+	}
+}
+
+func TestImportMashDump(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeBottomKDB(t, fsys)
+	binary := []byte(fsys.read(t, "bottomk.phash"))
+	plasmids, err := messagePackDecoding(&binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// mash info -d lists the hashes of each sketch in ascending order.
+	dump := mashDump{Kmer: 16, Alphabet: "ACGT", Canonical: true, SketchSize: 128, HashType: "MurmurHash3_x64_128", HashBits: 64, HashSeed: 42}
+	for _, record := range plasmids.Plasmid {
+		dump.Sketches = append(dump.Sketches, mashSketch{Name: record.AccID, Hashes: record.PlasmidMinHashValue})
+	}
+	in, err := json.Marshal(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys.write("plasmids.msh.json", string(in))
+
+	imp := &importOptions{In: "plasmids.msh.json", Metadata: testRefPhylum, Out: "imported.phash", FS: fsys}
+	if err := runImport(imp, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if fsys.read(t, "imported.phash") != fsys.read(t, "bottomk.phash") {
+		t.Error("the database imported from Mash differs from that of makedb")
+	}
+
+	imp = &importOptions{In: "plasmids.msh.json", Out: "k21.phash", Kmer: 21, FS: fsys}
+	err = runImport(imp, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "plasmids.msh.json holds sketches of k=16, not 21" {
+		t.Errorf("got error %v for sketches of another k-mer length", err)
+	}
+}
+
+func TestCalcBottomKAbundance(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	repeat := randomSequence(rng, 1000)
+	read := letters(repeat + repeat)
+	hasher := murmur3Hasher{}
+
+	values, counts, kmers := calcBottomKAbundance(read, 16, 4000, hasher, nil)
+	if want, _ := calcBottomK(read, 16, 4000, hasher, nil); !reflect.DeepEqual(values, want) || kmers != len(want) {
+		t.Fatalf("got %d values of %d k-mers, want those of calcBottomK", len(values), kmers)
+	}
+	// The 985 k-mers of the repeat occur twice, the 15 across the junction
+	// once.
+	var total, twice uint32
+	for _, n := range counts {
+		total += n
+		if n == 2 {
+			twice++
+		}
+	}
+	if len(counts) != len(values) || total != 1985 || twice != 985 {
+		t.Errorf("got %d counts adding up to %d, %d of them 2, want 1000 adding up to 1985, 985 of them 2", len(counts), total, twice)
+	}
+
+	short, shortCounts, _ := calcBottomKAbundance(read, 16, 128, hasher, nil)
+	if !reflect.DeepEqual(short, values[:128]) || !reflect.DeepEqual(shortCounts, counts[:128]) {
+		t.Error("the sketch of 128 is not the first 128 values and counts")
+	}
+}
+
+func TestWeightedSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b PlasmidRecord
+		want float32
+	}{
+		{PlasmidRecord{PlasmidMinHashValue: []uint64{1, 2, 3}, Abundances: []uint32{1, 1, 1}}, PlasmidRecord{PlasmidMinHashValue: []uint64{1, 2, 3}, Abundances: []uint32{1, 1, 1}}, 1},
+		{PlasmidRecord{PlasmidMinHashValue: []uint64{1, 2, 3}, Abundances: []uint32{1, 1, 1}}, PlasmidRecord{PlasmidMinHashValue: []uint64{1, 2, 3}, Abundances: []uint32{2, 1, 1}}, 0.75},
+		{PlasmidRecord{PlasmidMinHashValue: []uint64{1, 2}, Abundances: []uint32{3, 1}}, PlasmidRecord{PlasmidMinHashValue: []uint64{2, 4}, Abundances: []uint32{1, 4}}, 0.125},
+		{PlasmidRecord{PlasmidMinHashValue: []uint64{1, 3}}, PlasmidRecord{PlasmidMinHashValue: []uint64{2, 4}}, 0},
+		{PlasmidRecord{}, PlasmidRecord{}, 0},
+	}
+	for _, tt := range tests {
+		if got := weightedSimilarity(tt.a, tt.b, 4); got != tt.want {
+			t.Errorf("weightedSimilarity(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDistWeighted(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	x, y := randomSequence(rng, 1000), randomSequence(rng, 1000)
+	fsys := newSyntheticFS()
+	fsys.write("repeat.fna", formatFasta([]fastaRecord{{id: "single", seq: x + y}, {id: "double", seq: x + x + y}}))
+
+	opts := &makedbOptions{In: "repeat.fna", Out: "abundance.phash", Kmer: 16, Sketch: 4000, Hash: "murmur3", Scheme: "kmins", Abundance: true, FS: fsys}
+	err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "abundances are only kept by bottomk sketches, not kmins (use --scheme bottomk)" {
+		t.Errorf("got error %v for abundances of kmins sketches", err)
+	}
+	opts.Scheme = "bottomk"
+	if err := runMakedb(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("makedb: %v", err)
+	}
+
+	similarity := func(weighted bool) float64 {
+		t.Helper()
+		dist := &distOptions{DB: "abundance.phash", Format: "long", Metric: "jaccard", Weighted: weighted, Out: "dist.tsv", Force: true, FS: fsys}
+		if err := runDist(context.Background(), dist, ioutil.Discard, ioutil.Discard); err != nil {
+			t.Fatalf("dist: %v", err)
+		}
+		fields := strings.Split(strings.Split(fsys.read(t, "dist.tsv"), "\n")[1], "\t")
+		value, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+	// The plasmids share 1985 of 2000 k-mers, but the repeat doubles the
+	// counts of 985 of them: 1985 of 2985 k-mers weighted.
+	if got := similarity(false); got < 0.99 {
+		t.Errorf("similarity %v, want 1985/2000", got)
+	}
+	if got := similarity(true); got < 0.66 || got > 0.67 {
+		t.Errorf("weighted similarity %v, want 1985/2985", got)
+	}
+
+	// sketch --db keeps the counts for a database with them.
+	sketch := &sketchOptions{In: "repeat.fna", Out: "repeat.phs", DB: "abundance.phash", FS: fsys}
+	if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+	dist := &distOptions{DB: "abundance.phash", DB2: "repeat.phs", Format: "long", Metric: "jaccard", Weighted: true, Out: "dist.tsv", Force: true, FS: fsys}
+	if err := runDist(context.Background(), dist, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("dist: %v", err)
+	}
+	if got := strings.Split(fsys.read(t, "dist.tsv"), "\n")[4]; got != "double\tdouble\t1.000000\t0.000000" {
+		t.Errorf("got %q comparing the database with its sketch file", got)
+	}
+
+	makeSyntheticDB(t, fsys)
+	dist = &distOptions{DB: testDB, Format: "long", Metric: "jaccard", Weighted: true, FS: fsys}
+	err = runDist(context.Background(), dist, ioutil.Discard, ioutil.Discard)
+	if err == nil || !strings.Contains(err.Error(), "--weighted needs databases or sketch files with abundances") {
+		t.Errorf("got error %v for --weighted without abundances", err)
+	}
+
+	export := &exportOptions{DB: "abundance.phash", Out: "abundance.sig", FS: fsys}
+	if err := runExport(export, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	var signatures []sourmashSignature
+	if err := json.Unmarshal([]byte(fsys.read(t, "abundance.sig")), &signatures); err != nil {
+		t.Fatal(err)
+	}
+	if mh := signatures[1].Signatures[0]; len(mh.Abundances) != len(mh.Mins) {
+		t.Errorf("%s exported with %d abundances for %d values", signatures[1].Name, len(mh.Abundances), len(mh.Mins))
+	}
+	imp := &importOptions{In: "abundance.sig", Out: "imported.phash", FS: fsys}
+	if err := runImport(imp, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if fsys.read(t, "imported.phash") != fsys.read(t, "abundance.phash") {
+		t.Error("the database imported with abundances differs from the exported one")
+	}
+}
@@ -0,0 +1,436 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/spf13/cobra"
+)
+
+type serveOptions struct {
+	DBs         []string
+	DataDir     string
+	Listen      string
+	MaxBody     int64
+	MaxRequests int
+	Timeout     time.Duration
+	Link        string
+	FS          fileSystem
+}
+
+// servedDatabase is a database kept in memory by serve.
+type servedDatabase struct {
+	Name     string
+	Path     string
+	plasmids Plasmids
+	sketcher sketcher
+}
+
+// server answers identify requests against databases held in memory.
+// Requests beyond maxRequests at a time are turned away rather than queued,
+// so a burst of submissions cannot exhaust the memory of the server.
+type server struct {
+	databases map[string]*servedDatabase
+	names     []string
+	maxBody   int64
+	timeout   time.Duration
+	link      string
+	slots     chan struct{}
+
+	mutex sync.Mutex
+	ready bool
+}
+
+type (
+	databaseResponse struct {
+		Name        string `json:"name"`
+		Path        string `json:"path"`
+		Kmer        int    `json:"kmer"`
+		SketchSize  uint64 `json:"sketch_size"`
+		Hash        string `json:"hash"`
+		Scheme      string `json:"scheme"`
+		Plasmids    int    `json:"plasmids"`
+		Chromosomes int    `json:"chromosomes"`
+	}
+
+	identifyResponse struct {
+		Run     runInfo       `json:"run"`
+		Contigs int           `json:"contigs"`
+		Skipped []skipSummary `json:"skipped,omitempty"`
+		Hits    []hitRecord   `json:"hits"`
+	}
+
+	errorResponse struct {
+		Error string `json:"error"`
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(newServeCmd())
+}
+
+func newServeCmd() *cobra.Command {
+	opts := &serveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Server of identify",
+		Long:  "HTTP server identifying plasmids with databases kept in memory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServe(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.DBs, "db", "d", nil, "Database to serve as [NAME=]FILE or NAME[@VERSION] installed by init (repeatable)")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().StringVar(&opts.Listen, "listen", "127.0.0.1:8080", "Address to listen on")
+	cmd.Flags().Int64Var(&opts.MaxBody, "max-body", 64<<20, "Maximum size of a request body in bytes")
+	cmd.Flags().IntVar(&opts.MaxRequests, "max-requests", 4, "Maximum number of identify requests at a time")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "Maximum duration of an identify request")
+	cmd.Flags().StringVar(&opts.Link, "link", defaultLinkPattern, "URL pattern of hit links, {acc} is replaced by the accession")
+
+	return cmd
+}
+
+func runServe(ctx context.Context, opts *serveOptions, stdout io.Writer, stderr io.Writer) error {
+	if len(opts.DBs) == 0 {
+		return errors.New("--db is required")
+	}
+	if opts.MaxRequests < 1 {
+		return errors.New("--max-requests must be at least 1")
+	}
+	fsys := orOSFS(opts.FS)
+
+	listener, err := net.Listen("tcp", opts.Listen)
+	if err != nil {
+		return err
+	}
+	srv := newServer(opts)
+	httpServer := &http.Server{Handler: srv.handler(), ReadHeaderTimeout: 30 * time.Second}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case sig := <-signals:
+			fmt.Fprintf(stderr, "Received %s, shutting down\n", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// The health endpoints answer while the databases are being decoded.
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+	fmt.Fprintf(stderr, "Listening on %s\n", listener.Addr())
+	if err := srv.load(fsys, opts.DBs, opts.DataDir); err != nil {
+		httpServer.Close()
+		return err
+	}
+	fmt.Fprintf(stderr, "Serving %s\n", strings.Join(srv.names, ", "))
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Stop accepting requests and wait for the running ones to finish.
+	shutdown, cancelShutdown := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancelShutdown()
+	if err := httpServer.Shutdown(shutdown); err != nil {
+		return err
+	}
+	if err := <-errs; err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func newServer(opts *serveOptions) *server {
+	return &server{
+		maxBody: opts.MaxBody,
+		timeout: opts.Timeout,
+		link:    opts.Link,
+		slots:   make(chan struct{}, opts.MaxRequests),
+	}
+}
+
+// load decodes the databases, named by NAME=FILE, by their installed name or
+// by their file name, and makes the server ready.
+func (s *server) load(fsys fileSystem, refs []string, dataDir string) error {
+	databases := map[string]*servedDatabase{}
+	var names []string
+	for _, ref := range refs {
+		name := ""
+		if i := strings.Index(ref, "="); i > 0 {
+			name, ref = ref[:i], ref[i+1:]
+		}
+		plasmids, path, err := loadDatabase(fsys, ref, dataDir)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			name, _ = splitDatabaseRef(strings.TrimSuffix(filepath.Base(path), databaseExt))
+		}
+		if _, ok := databases[name]; ok {
+			return fmt.Errorf("database name %s is used twice", name)
+		}
+		sk, err := plasmids.sketcher()
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		databases[name] = &servedDatabase{Name: name, Path: path, plasmids: plasmids, sketcher: sk}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	s.mutex.Lock()
+	s.databases, s.names, s.ready = databases, names, true
+	s.mutex.Unlock()
+	return nil
+}
+
+func (s *server) isReady() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.ready
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !s.isReady() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "loading"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+	})
+	mux.HandleFunc("/v1/databases", s.handleDatabases)
+	mux.HandleFunc("/v1/identify", s.handleIdentify)
+	return mux
+}
+
+func (s *server) handleDatabases(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "use GET")
+		return
+	}
+	if !s.isReady() {
+		writeError(w, http.StatusServiceUnavailable, "databases are loading")
+		return
+	}
+	databases := []databaseResponse{}
+	for _, name := range s.names {
+		db := s.databases[name]
+		databases = append(databases, databaseResponse{
+			Name:        name,
+			Path:        db.Path,
+			Kmer:        db.plasmids.Kmer,
+			SketchSize:  db.plasmids.SketchSize,
+			Hash:        db.sketcher.hasher.Name(),
+			Scheme:      db.sketcher.scheme.Name(),
+			Plasmids:    len(db.plasmids.Plasmid),
+			Chromosomes: len(db.plasmids.Chromosome),
+		})
+	}
+	writeJSON(w, http.StatusOK, databases)
+}
+
+// handleIdentify identifies the contigs of a FASTA request body. The query
+// parameters db (required with several databases), threshold, margin, window,
+// step, min_length and min_kmers have the meaning of the identify flags.
+func (s *server) handleIdentify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "use POST with a FASTA body")
+		return
+	}
+	if !s.isReady() {
+		writeError(w, http.StatusServiceUnavailable, "databases are loading")
+		return
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	default:
+		w.Header().Set("Retry-After", "10")
+		writeError(w, http.StatusServiceUnavailable, "too many requests, try again later")
+		return
+	}
+
+	query := r.URL.Query()
+	name := query.Get("db")
+	if name == "" && len(s.names) == 1 {
+		name = s.names[0]
+	}
+	db, ok := s.databases[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown database %q (choose from %s)", name, strings.Join(s.names, ", ")))
+		return
+	}
+
+	params := map[string]int{"threshold": 10, "margin": 5, "window": 0, "step": 0, "min_length": 0, "min_kmers": 0}
+	for param := range params {
+		if value := query.Get(param); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("%s must be a non-negative integer", param))
+				return
+			}
+			params[param] = n
+		}
+	}
+	window, step := params["window"], params["step"]
+	if step == 0 {
+		step = window / 2
+	}
+	if window > 0 && (window < db.plasmids.Kmer || step < 1) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("window must be at least the k-mer length (%d)", db.plasmids.Kmer))
+		return
+	}
+
+	id := &identifier{
+		sketcher:    db.sketcher,
+		records:     db.plasmids.Plasmid,
+		chromosomes: db.plasmids.Chromosome,
+		threshold:   float32(params["threshold"]) * 0.01,
+		margin:      float32(params["margin"]) * 0.01,
+		window:      window,
+		step:        step,
+		link:        s.link,
+		minLength:   params["min_length"],
+		minKmers:    params["min_kmers"],
+	}
+	run := runInfo{
+		Version:    version,
+		Database:   name,
+		Kmer:       id.k,
+		SketchSize: id.sketchSize,
+		Plasmids:   len(id.records),
+		Hash:       db.plasmids.Hash,
+		Scheme:     db.plasmids.Scheme,
+		Masked:     len(db.plasmids.Mask),
+		Threshold:  float64(params["threshold"]) / 100,
+		Window:     window,
+		Step:       step,
+		MinLength:  params["min_length"],
+		MinKmers:   params["min_kmers"],
+	}
+	if len(id.chromosomes) > 0 {
+		run.Chromosomes = len(id.chromosomes)
+		run.Margin = float64(params["margin"]) / 100
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+
+	body := &limitedBody{r: r.Body, left: s.maxBody}
+	in := fasta.NewReader(body, linear.NewSeq("", nil, alphabet.DNA))
+
+	mutex := new(sync.Mutex)
+	results := map[int]contigResult{}
+	lengths := map[int]int{}
+	err := readSequences(ctx, in, 64, func(i int, sequence seq.Sequence) error {
+		result := id.identify(id.newQuery(sequence))
+		mutex.Lock()
+		results[i] = result
+		lengths[i] = sequence.Len()
+		mutex.Unlock()
+		return nil
+	})
+	switch {
+	case body.exceeded:
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body is larger than %d bytes", s.maxBody))
+		return
+	case err != nil && ctx.Err() == context.DeadlineExceeded:
+		writeError(w, http.StatusServiceUnavailable, "request timed out")
+		return
+	case err != nil && r.Context().Err() != nil:
+		// The client has gone and reads no response.
+		return
+	case err != nil:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid FASTA: %v", err))
+		return
+	}
+
+	response := identifyResponse{Run: run, Contigs: len(results), Hits: []hitRecord{}}
+	for i := 0; i < len(results); i++ {
+		if results[i].skipped != "" {
+			response.Skipped = addSkipped(response.Skipped, results[i].skipped, lengths[i])
+		}
+		response.Hits = append(response.Hits, results[i].records...)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// limitedBody reads a request body up to left bytes and fails with
+// errBodyTooLarge, setting exceeded, when the client sends more.
+type limitedBody struct {
+	r        io.Reader
+	left     int64
+	exceeded bool
+}
+
+var errBodyTooLarge = errors.New("request body too large")
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.left <= 0 {
+		// A body of exactly the limit ends here; one more byte is too many.
+		var one [1]byte
+		if n, err := b.r.Read(one[:]); n == 0 {
+			return 0, err
+		}
+		b.exceeded = true
+		return 0, errBodyTooLarge
+	}
+	if int64(len(p)) > b.left {
+		p = p[:b.left]
+	}
+	n, err := b.r.Read(p)
+	b.left -= int64(n)
+	return n, err
+}
+
+// writeJSON encodes v before writing the status, so that a value that cannot
+// be encoded gets a 500 instead of a truncated 200.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error encoding response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(b, '\n'))
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
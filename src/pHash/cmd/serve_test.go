@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, fsys *memFS, maxBody int64, maxRequests int) (*server, *httptest.Server) {
+	t.Helper()
+	srv := newServer(&serveOptions{MaxBody: maxBody, MaxRequests: maxRequests, Timeout: time.Minute, Link: defaultLinkPattern})
+	ts := httptest.NewServer(srv.handler())
+	t.Cleanup(ts.Close)
+	return srv, ts
+}
+
+func TestServeIdentify(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	srv, ts := newTestServer(t, fsys, 1<<20, 2)
+
+	if status := getStatus(t, ts.URL+"/readyz"); status != http.StatusServiceUnavailable {
+		t.Errorf("readyz before loading = %d, want 503", status)
+	}
+	if status := getStatus(t, ts.URL+"/healthz"); status != http.StatusOK {
+		t.Errorf("healthz = %d, want 200", status)
+	}
+	if err := srv.load(fsys, []string{"synthetic=" + testDB}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if status := getStatus(t, ts.URL+"/readyz"); status != http.StatusOK {
+		t.Errorf("readyz after loading = %d, want 200", status)
+	}
+
+	resp, err := http.Post(ts.URL+"/v1/identify?threshold=20", "text/plain", strings.NewReader(fsys.read(t, testQueries)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d, want 200", resp.StatusCode)
+	}
+	var got identifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Contigs != 5 || got.Run.Database != "synthetic" || got.Run.Threshold != 0.2 {
+		t.Errorf("got %d contigs, database %s and threshold %v", got.Contigs, got.Run.Database, got.Run.Threshold)
+	}
+	for i, name := range []string{"exact", "fragment", "mutated", "chimera", "unrelated"} {
+		if got.Hits[i].ContigID != name {
+			t.Errorf("hit %d is %s, want %s", i, got.Hits[i].ContigID, name)
+		}
+	}
+	if !got.Hits[0].PassThreshold || got.Hits[0].HitAccID != "NZ_SYN0001.1" || got.Hits[4].PassThreshold {
+		t.Errorf("got hits %+v", got.Hits)
+	}
+}
+
+func TestServeErrors(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	fsys.write("other.phash", fsys.read(t, testDB))
+	srv, ts := newTestServer(t, fsys, 1000, 1)
+	if err := srv.load(fsys, []string{testDB, "other.phash"}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{"wrong method", http.MethodGet, "/v1/identify", "", http.StatusMethodNotAllowed},
+		{"no database chosen", http.MethodPost, "/v1/identify", ">a\nACGT\n", http.StatusNotFound},
+		{"invalid threshold", http.MethodPost, "/v1/identify?db=other&threshold=x", ">a\nACGT\n", http.StatusBadRequest},
+		{"window shorter than k", http.MethodPost, "/v1/identify?db=other&window=8", ">a\nACGT\n", http.StatusBadRequest},
+		{"negative min_length", http.MethodPost, "/v1/identify?db=other&min_length=-1", ">a\nACGT\n", http.StatusBadRequest},
+		{"negative min_kmers", http.MethodPost, "/v1/identify?db=other&min_kmers=-5", ">a\nACGT\n", http.StatusBadRequest},
+		{"body too large", http.MethodPost, "/v1/identify?db=synthetic", fsys.read(t, testQueries), http.StatusRequestEntityTooLarge},
+		{"small body", http.MethodPost, "/v1/identify?db=synthetic", ">a\nACGTACGTACGTACGTACGT\n", http.StatusOK},
+		{"body at the limit", http.MethodPost, "/v1/identify?db=synthetic", ">a\n" + strings.Repeat("ACGT", 249) + "\n", http.StatusOK},
+		{"body over the limit", http.MethodPost, "/v1/identify?db=synthetic", ">a\n" + strings.Repeat("ACGT", 249) + "A\n", http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, ts.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("status %d, want %d", resp.StatusCode, tt.want)
+			}
+		})
+	}
+
+	// With the only slot taken, requests are turned away.
+	srv.slots <- struct{}{}
+	resp, err := http.Post(ts.URL+"/v1/identify?db=synthetic", "text/plain", strings.NewReader(">a\nACGT\n"))
+	<-srv.slots
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get("Retry-After") == "" {
+		t.Errorf("status %d with Retry-After %q, want 503", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+}
+
+func TestServeDisconnect(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	srv, _ := newTestServer(t, fsys, 1<<20, 1)
+	if err := srv.load(fsys, []string{testDB}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodPost, "/v1/identify", strings.NewReader(fsys.read(t, testQueries))).WithContext(ctx)
+	rec := httptest.NewRecorder()
+	srv.handler().ServeHTTP(rec, req)
+	if rec.Body.Len() != 0 {
+		t.Errorf("answered a client that has gone with %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestWriteJSONError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeJSON(rec, http.StatusOK, math.NaN())
+	if rec.Code != http.StatusInternalServerError || !strings.HasPrefix(rec.Body.String(), "error encoding response: ") {
+		t.Errorf("got %d %q for a value that cannot be encoded, want 500", rec.Code, rec.Body.String())
+	}
+}
+
+func TestServeShutdown(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		opts := &serveOptions{DBs: []string{testDB}, Listen: "127.0.0.1:0", MaxBody: 1 << 20, MaxRequests: 1, Timeout: time.Minute, FS: fsys}
+		done <- runServe(ctx, opts, ioutil.Discard, ioutil.Discard)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serve: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("serve did not shut down")
+	}
+}
+
+func getStatus(t *testing.T, url string) int {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
@@ -0,0 +1,288 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/spf13/cobra"
+)
+
+type simulateOptions struct {
+	In       string
+	Out      string
+	Num      int
+	Dist     string
+	Circular bool
+	ReplaceN bool
+	Sub      float64
+	Ins      float64
+	Del      float64
+	Seed     int64
+	Force    bool
+	FS       fileSystem
+}
+
+// lengthDistributions draw n fragment lengths. They follow the fits of the
+// original benchmark script, including its use of scipy's positional
+// arguments: the second parameter of both gamma components is a location
+// shift, not a rate.
+var lengthDistributions = map[string]func(rng *rand.Rand, n int) []float64{
+	// S18 reads: beta(1.778, 7.892) scaled to [316.758, 34508.015)
+	"beta": func(rng *rand.Rand, n int) []float64 {
+		return drawLengths(n, func() float64 {
+			x, y := gammaRand(rng, 1.778), gammaRand(rng, 7.892)
+			return 316.758 + 34191.257*x/(x+y)
+		})
+	},
+	// exponential with location 213.989 and scale 6972.532
+	"expon": func(rng *rand.Rand, n int) []float64 {
+		return drawLengths(n, func() float64 {
+			return 213.989 + 6972.532*rng.ExpFloat64()
+		})
+	},
+	// lambda reads: half from gamma(6.369) + 0.538, half from
+	// gamma(1.676) + 0.229, in kb
+	"mixgamma": func(rng *rand.Rand, n int) []float64 {
+		half := n / 2
+		lengths := append(
+			drawLengths(half, func() float64 { return 1000 * (0.53834893 + gammaRand(rng, 6.3693711)) }),
+			drawLengths(n-half, func() float64 { return 1000 * (0.22871401 + gammaRand(rng, 1.67638771)) })...)
+		rng.Shuffle(len(lengths), func(i, j int) { lengths[i], lengths[j] = lengths[j], lengths[i] })
+		return lengths
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(newSimulateCmd())
+}
+
+func newSimulateCmd() *cobra.Command {
+	opts := &simulateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulator of contigs",
+		Long:  "Simulator of contigs sampled from plasmid genomes for benchmarking",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSimulate(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Input FASTA file of genomes")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "simulated.fna", "Output FASTA file")
+	cmd.Flags().IntVarP(&opts.Num, "num", "n", 100, "Number of fragments per genome")
+	cmd.Flags().StringVar(&opts.Dist, "dist", "mixgamma", "Length distribution ("+strings.Join(distributionNames(), ", ")+")")
+	cmd.Flags().BoolVarP(&opts.Circular, "circular", "c", false, "Genomes are circular, fragments may span the origin")
+	cmd.Flags().BoolVar(&opts.ReplaceN, "replace-n", false, "Replace N with random bases instead of removing them")
+	cmd.Flags().Float64Var(&opts.Sub, "sub", 0, "Substitution rate per base")
+	cmd.Flags().Float64Var(&opts.Ins, "ins", 0, "Insertion rate per base")
+	cmd.Flags().Float64Var(&opts.Del, "del", 0, "Deletion rate per base")
+	cmd.Flags().Int64Var(&opts.Seed, "seed", 1, "Seed of the random number generator")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func distributionNames() []string {
+	var names []string
+	for name := range lengthDistributions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// runSimulate writes opts.Num fragments of every genome. A fragment is named
+// <genome>_<index> like in the original script; its description records the
+// true source for evaluation, e.g.
+//
+//	>NZ_CP007510.1_0 source=NZ_CP007510.1 start=1200 length=5120 errors=3
+//
+// start is the 0-based position in the genome after N handling and length
+// the number of genome bases covered, before errors are added.
+func runSimulate(ctx context.Context, opts *simulateOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.Out == "" {
+		return errors.New("--in and --out are required")
+	}
+	if opts.Num < 1 {
+		return errors.New("--num must be at least 1")
+	}
+	draw, ok := lengthDistributions[opts.Dist]
+	if !ok {
+		return fmt.Errorf("--dist must be one of %s", strings.Join(distributionNames(), ", "))
+	}
+	for _, rate := range []float64{opts.Sub, opts.Ins, opts.Del} {
+		if rate < 0 || rate >= 1 {
+			return errors.New("error rates must be in [0, 1)")
+		}
+	}
+	fsys := orOSFS(opts.FS)
+	if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+		return err
+	}
+	rng := rand.New(rand.NewSource(opts.Seed))
+
+	f, err := fsys.Open(opts.In)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	in := fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
+
+	fw, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer fw.Close()
+	fastaw := fasta.NewWriter(fw, 60)
+	fragment := linear.NewSeq("", nil, alphabet.DNA)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s, err := in.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		genome := strings.ToUpper(s.Slice().(alphabet.Letters).String())
+		if opts.ReplaceN {
+			genome = replaceN(rng, genome)
+		} else {
+			genome = strings.Replace(genome, "N", "", -1)
+		}
+		if len(genome) == 0 {
+			fmt.Fprintf(stderr, "Warning: %s has no bases, skipped\n", s.Name())
+			continue
+		}
+
+		for i, length := range draw(rng, opts.Num) {
+			n := clipLength(length, len(genome))
+			start, read := sampleFragment(rng, genome, n, opts.Circular)
+			read, errs := addErrors(rng, read, opts.Sub, opts.Ins, opts.Del)
+
+			fragment.ID = fmt.Sprintf("%s_%d", s.Name(), i)
+			fragment.Desc = fmt.Sprintf("source=%s start=%d length=%d errors=%d", s.Name(), start, n, errs)
+			fragment.Seq = alphabet.BytesToLetters(read)
+			if _, err := fastaw.Write(fragment); err != nil {
+				return err
+			}
+		}
+	}
+
+	return fw.Close()
+}
+
+func drawLengths(n int, draw func() float64) []float64 {
+	lengths := make([]float64, n)
+	for i := range lengths {
+		lengths[i] = draw()
+	}
+	return lengths
+}
+
+// gammaRand draws from the gamma distribution with the given shape and unit
+// scale (Marsaglia and Tsang, 2000).
+func gammaRand(rng *rand.Rand, shape float64) float64 {
+	if shape < 1 {
+		return gammaRand(rng, shape+1) * math.Pow(rng.Float64(), 1/shape)
+	}
+
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rng.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := rng.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// clipLength truncates a drawn length and clips it to [1, max].
+func clipLength(length float64, max int) int {
+	n := int(length)
+	if n < 1 {
+		return 1
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+// sampleFragment returns a random start and the n bases from there, wrapping
+// around the origin of circular genomes.
+func sampleFragment(rng *rand.Rand, genome string, n int, circular bool) (int, []byte) {
+	if !circular {
+		start := rng.Intn(len(genome) - n + 1)
+		return start, []byte(genome[start : start+n])
+	}
+
+	start := rng.Intn(len(genome))
+	if start+n <= len(genome) {
+		return start, []byte(genome[start : start+n])
+	}
+	return start, []byte(genome[start:] + genome[:start+n-len(genome)])
+}
+
+func replaceN(rng *rand.Rand, genome string) string {
+	const bases = "ATCG"
+	b := []byte(genome)
+	for i, c := range b {
+		if c == 'N' {
+			b[i] = bases[rng.Intn(len(bases))]
+		}
+	}
+	return string(b)
+}
+
+// addErrors applies substitutions, insertions after and deletions of each base
+// independently with the given rates and returns the number of errors.
+func addErrors(rng *rand.Rand, read []byte, sub float64, ins float64, del float64) ([]byte, int) {
+	if sub == 0 && ins == 0 && del == 0 {
+		return read, 0
+	}
+
+	const bases = "ACGT"
+	var errs int
+	out := make([]byte, 0, len(read))
+	for _, c := range read {
+		switch r := rng.Float64(); {
+		case r < del:
+			errs++
+		case r < del+sub:
+			e := c
+			for e == c {
+				e = bases[rng.Intn(len(bases))]
+			}
+			out = append(out, e)
+			errs++
+		default:
+			out = append(out, c)
+		}
+		if rng.Float64() < ins {
+			out = append(out, bases[rng.Intn(len(bases))])
+			errs++
+		}
+	}
+	return out, errs
+}
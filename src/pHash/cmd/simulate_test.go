@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var simulatedHeader = regexp.MustCompile(`^>(\S+)_(\d+) source=(\S+) start=(\d+) length=(\d+) errors=(\d+)$`)
+
+type simulatedFragment struct {
+	source string
+	start  int
+	length int
+	errors int
+	seq    string
+}
+
+func parseSimulated(t *testing.T, contents string) []simulatedFragment {
+	t.Helper()
+	var fragments []simulatedFragment
+	for _, line := range strings.Split(strings.TrimSpace(contents), "\n") {
+		if !strings.HasPrefix(line, ">") {
+			fragments[len(fragments)-1].seq += line
+			continue
+		}
+		m := simulatedHeader.FindStringSubmatch(line)
+		if m == nil {
+			t.Fatalf("bad header %q", line)
+		}
+		if m[1] != m[3] {
+			t.Errorf("fragment %s_%s is labelled with source %s", m[1], m[2], m[3])
+		}
+		start, _ := strconv.Atoi(m[4])
+		length, _ := strconv.Atoi(m[5])
+		errs, _ := strconv.Atoi(m[6])
+		fragments = append(fragments, simulatedFragment{source: m[3], start: start, length: length, errors: errs})
+	}
+	return fragments
+}
+
+func TestSimulate(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	genomes := map[string]string{
+		"plasmidA": randomSequence(rng, 20000),
+		"plasmidB": randomSequence(rng, 3000),
+	}
+	input := formatFasta([]fastaRecord{{id: "plasmidA", seq: genomes["plasmidA"]}, {id: "plasmidB", seq: genomes["plasmidB"]}})
+
+	for _, dist := range distributionNames() {
+		for _, circular := range []bool{false, true} {
+			fsys := newMemFS()
+			fsys.write("genomes.fna", input)
+			opts := &simulateOptions{In: "genomes.fna", Out: "sim.fna", Num: 50, Dist: dist, Circular: circular, Seed: 7, FS: fsys}
+			if err := runSimulate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("%s: %v", dist, err)
+			}
+			out := fsys.read(t, "sim.fna")
+
+			fragments := parseSimulated(t, out)
+			if len(fragments) != 100 {
+				t.Fatalf("%s: %d fragments, want 100", dist, len(fragments))
+			}
+			for _, f := range fragments {
+				genome := genomes[f.source]
+				if f.length < 1 || f.length > len(genome) {
+					t.Errorf("%s: length %d outside [1, %d]", dist, f.length, len(genome))
+					continue
+				}
+				want := (genome + genome)[f.start : f.start+f.length]
+				if !circular && f.start+f.length > len(genome) {
+					t.Errorf("%s: linear fragment %d+%d runs past the end of %s", dist, f.start, f.length, f.source)
+				}
+				if f.seq != want || f.errors != 0 {
+					t.Errorf("%s: fragment at %s:%d differs from the genome", dist, f.source, f.start)
+				}
+			}
+
+			if err := runSimulate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err == nil || !strings.HasPrefix(err.Error(), "output already exists") {
+				t.Fatalf("got error %v, want output already exists", err)
+			}
+			opts.Force = true
+			if err := runSimulate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatal(err)
+			}
+			if fsys.read(t, "sim.fna") != out {
+				t.Errorf("%s: same seed gave different fragments", dist)
+			}
+		}
+	}
+}
+
+func TestSimulateOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts simulateOptions
+		want string
+	}{
+		{name: "zero num", opts: simulateOptions{Num: 0, Dist: "mixgamma"}, want: "--num must be at least 1"},
+		{name: "negative num", opts: simulateOptions{Num: -1, Dist: "mixgamma"}, want: "--num must be at least 1"},
+		{name: "dist", opts: simulateOptions{Num: 1, Dist: "uniform"}, want: "--dist must be one of "},
+		{name: "rate", opts: simulateOptions{Num: 1, Dist: "mixgamma", Sub: 1}, want: "error rates must be in [0, 1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newMemFS()
+			fsys.write("genomes.fna", ">g\nACGTACGTAC\n")
+			opts := tt.opts
+			opts.In, opts.Out, opts.FS = "genomes.fna", "sim.fna", fsys
+			err := runSimulate(context.Background(), &opts, ioutil.Discard, ioutil.Discard)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateN(t *testing.T) {
+	fsys := newMemFS()
+	fsys.write("genomes.fna", ">withN\nACGTNNNNACGT\n>onlyN\nNNNN\n")
+
+	opts := &simulateOptions{In: "genomes.fna", Out: "sim.fna", Num: 5, Dist: "mixgamma", Seed: 1, FS: fsys}
+	if err := runSimulate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range parseSimulated(t, fsys.read(t, "sim.fna")) {
+		if f.source != "withN" || f.seq != "ACGTACGT" {
+			t.Errorf("got %s %q, want the N-free withN", f.source, f.seq)
+		}
+	}
+
+	opts.ReplaceN, opts.Force = true, true
+	if err := runSimulate(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range parseSimulated(t, fsys.read(t, "sim.fna")) {
+		want := map[string]int{"withN": 12, "onlyN": 4}[f.source]
+		if len(f.seq) != want || strings.Contains(f.seq, "N") {
+			t.Errorf("got %s %q, want %d bases without N", f.source, f.seq, want)
+		}
+	}
+}
+
+func TestLengthDistributions(t *testing.T) {
+	// means of the fitted distributions as sampled by the original script
+	means := map[string]float64{
+		"beta":     316.758 + 34191.257*1.778/(1.778+7.892),
+		"expon":    213.989 + 6972.532,
+		"mixgamma": 1000 * (0.53834893 + 6.3693711 + 0.22871401 + 1.67638771) / 2,
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for _, name := range distributionNames() {
+		want := means[name]
+		lengths := lengthDistributions[name](rng, 20000)
+		var sum float64
+		for _, l := range lengths {
+			sum += l
+		}
+		if got := sum / float64(len(lengths)); math.Abs(got-want) > 0.03*want {
+			t.Errorf("%s: mean length %.0f, want about %.0f", name, got, want)
+		}
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	read := []byte(randomSequence(rng, 100000))
+
+	out, errs := addErrors(rng, read, 0.01, 0.005, 0.005)
+	if rate := float64(errs) / float64(len(read)); math.Abs(rate-0.02) > 0.002 {
+		t.Errorf("error rate %.4f, want about 0.02", rate)
+	}
+	if d := len(out) - len(read); d < -200 || d > 200 {
+		t.Errorf("length changed by %d, insertions and deletions should balance", d)
+	}
+}
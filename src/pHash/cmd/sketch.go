@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
+	"github.com/biogo/biogo/seq/linear"
+	"github.com/spf13/cobra"
+	"github.com/ugorji/go/codec"
+)
+
+// Sketch files hold the sketches of the contigs of a query assembly, so that
+// a sample hashed once can be searched against other databases. Format tells
+// them from databases, which decode from the same MessagePack.
+type (
+	ContigSketch struct {
+		Name         string
+		Description  string
+		Length       int
+		Kmers        int
+		MinHashValue []uint64
+		Abundances   []uint32 `codec:",omitempty"`
+
+		source string // sequence a chunk was cut from
+	}
+
+	Sketches struct {
+		Format     string
+		SketchSize uint64
+		Kmer       int
+		Contig     []ContigSketch
+
+		// Hash, Scheme and Abundance are as in databases, and Mask is the
+		// digest of the mask of the database sketched for.
+		Hash      string `codec:",omitempty"`
+		Scheme    string `codec:",omitempty"`
+		Abundance bool   `codec:",omitempty"`
+		Mask      string `codec:",omitempty"`
+	}
+)
+
+const sketchFormat = "phash-sketch"
+
+type sketchOptions struct {
+	In        string
+	Out       string
+	DB        string
+	DataDir   string
+	Kmer      int
+	Sketch    int
+	Hash      string
+	Scheme    string
+	Abundance bool
+	Force     bool
+	FS        fileSystem
+}
+
+func init() {
+	RootCmd.AddCommand(newSketchCmd())
+}
+
+func newSketchCmd() *cobra.Command {
+	opts := &sketchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sketch",
+		Short: "Sketcher of query contigs",
+		Long:  "Sketcher of the contigs of a query assembly, for identify and dist to search without hashing again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSketch(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.In, "in", "i", "", "Input FASTA file")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Sketch file")
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Take the k-mer length, sketch size, hash function, scheme and mask from this database, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().IntVarP(&opts.Kmer, "kmer", "k", 16, "Length of k-mer")
+	cmd.Flags().IntVarP(&opts.Sketch, "sketch", "s", 512, "Sketch size")
+	cmd.Flags().StringVar(&opts.Hash, "hash", "xxhash64", "Hash function of sketches ("+strings.Join(hasherNames(), ", ")+")")
+	cmd.Flags().StringVar(&opts.Scheme, "scheme", "kmins", "Sketch scheme ("+strings.Join(schemeNames(), ", ")+")")
+	cmd.Flags().BoolVar(&opts.Abundance, "abundance", false, "Keep the counts of the k-mers of bottomk sketches")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runSketch(ctx context.Context, opts *sketchOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.In == "" || opts.Out == "" {
+		return errors.New("--in and --out are required")
+	}
+	fsys := orOSFS(opts.FS)
+	if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+		return err
+	}
+
+	k, sketchSize, hash, scheme, abundance := opts.Kmer, uint64(opts.Sketch), opts.Hash, opts.Scheme, opts.Abundance
+	var maskKeys []uint64
+	if opts.DB != "" {
+		plasmids, _, err := loadDatabase(fsys, opts.DB, opts.DataDir)
+		if err != nil {
+			return err
+		}
+		k, sketchSize, hash, scheme, maskKeys = plasmids.Kmer, plasmids.SketchSize, plasmids.Hash, plasmids.Scheme, plasmids.Mask
+		abundance = abundance || plasmids.Abundance
+	}
+	if k < 1 || sketchSize < 1 {
+		return errors.New("--kmer and --sketch must be positive")
+	}
+	sk, err := Plasmids{Kmer: k, SketchSize: sketchSize, Hash: hash, Scheme: scheme, Abundance: abundance, Mask: maskKeys}.sketcher()
+	if err != nil {
+		return err
+	}
+
+	runtime.GOMAXPROCS(runtime.NumCPU())
+	contigs, err := sk.sketchFasta(ctx, fsys, opts.In, 64, 0)
+	if err != nil {
+		return err
+	}
+	sketches := Sketches{Format: sketchFormat, SketchSize: sketchSize, Kmer: k, Contig: contigs, Hash: hashHeader(sk.hasher), Scheme: schemeHeader(sk.scheme), Abundance: sk.abundance, Mask: maskDigest(maskKeys)}
+
+	buf := make([]byte, 0, 64)
+	if err := codec.NewEncoderBytes(&buf, &mh).Encode(sketches); err != nil {
+		return fmt.Errorf("error encoding sketches to MessagePack: %v", err)
+	}
+	file, err := createOutput(fsys, opts.Out, opts.Force)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Write(buf); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "Sketched %d contigs (k=%d, sketch=%d, hash=%s, scheme=%s)\n", len(sketches.Contig), k, sketchSize, sk.hasher.Name(), sk.scheme.Name())
+	return nil
+}
+
+// sketcher sketches sequences with the k-mer length, sketch size, hash
+// function, scheme and mask of a database, and counts k-mers for databases
+// with abundances.
+type sketcher struct {
+	k          int
+	sketchSize uint64
+	hasher     Hasher
+	scheme     sketchScheme
+	abundance  bool
+	mask       kmerMask
+}
+
+// sketcher returns the sketcher of a database.
+func (p Plasmids) sketcher() (sketcher, error) {
+	hasher, err := lookupHasher(p.Hash)
+	if err != nil {
+		return sketcher{}, err
+	}
+	scheme, err := lookupScheme(p.Scheme)
+	if err != nil {
+		return sketcher{}, err
+	}
+	if err := checkAbundance(scheme, p.Abundance); err != nil {
+		return sketcher{}, err
+	}
+	return sketcher{k: p.Kmer, sketchSize: p.SketchSize, hasher: hasher, scheme: scheme, abundance: p.Abundance, mask: newKmerMask(p.Mask)}, nil
+}
+
+// sketch returns the sketch of read and its number of distinct k-mers.
+func (sk sketcher) sketch(read alphabet.Slice) ([]uint64, int) {
+	return sk.scheme.Sketch(read, sk.k, sk.sketchSize, sk.hasher, sk.mask)
+}
+
+// sketchCounts is sketch with the counts of the k-mers of the values for a
+// sketcher with abundance, and without counts otherwise.
+func (sk sketcher) sketchCounts(read alphabet.Slice) ([]uint64, []uint32, int) {
+	if !sk.abundance {
+		values, kmers := sk.sketch(read)
+		return values, nil, kmers
+	}
+	return calcBottomKAbundance(read, sk.k, sk.sketchSize, sk.hasher, sk.mask)
+}
+
+// sketchFasta sketches every sequence of a FASTA file from the given number of
+// goroutines and returns the sketches in input order, so that the same input
+// gives the same output. With a chunk length, sequences are sketched in chunks
+// overlapping by half, named <name>:<start>-<end>.
+func (sk sketcher) sketchFasta(ctx context.Context, fsys fileSystem, name string, workers int, chunk int) ([]ContigSketch, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	in := fasta.NewReader(f, linear.NewSeq("", nil, alphabet.DNA))
+
+	mutex := new(sync.Mutex)
+	sketches := map[int][]ContigSketch{}
+	err = readSequences(ctx, in, workers, func(i int, s seq.Sequence) error {
+		windows := [][2]int{{0, s.Len()}}
+		if chunk > 0 {
+			windows = slidingWindows(s.Len(), chunk, chunk/2)
+		}
+		contigs := make([]ContigSketch, 0, len(windows))
+		for _, w := range windows {
+			contig := ContigSketch{Name: s.Name(), Description: s.Description(), Length: w[1] - w[0], source: s.Name()}
+			if chunk > 0 {
+				contig.Name = fmt.Sprintf("%s:%d-%d", s.Name(), w[0], w[1])
+			}
+			contig.MinHashValue, contig.Abundances, contig.Kmers = sk.sketchCounts(s.Slice().Slice(w[0], w[1]))
+			contigs = append(contigs, contig)
+		}
+		mutex.Lock()
+		sketches[i] = contigs
+		mutex.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var contigs []ContigSketch
+	for i := 0; i < len(sketches); i++ {
+		contigs = append(contigs, sketches[i]...)
+	}
+	return contigs, nil
+}
+
+// isSketchFile tells a sketch file from FASTA by the Format of its header.
+// Text never decodes as a MessagePack map, so FASTA with a leading blank line,
+// byte order mark or comment is still read as FASTA.
+func isSketchFile(fsys fileSystem, path string) (bool, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	var header struct{ Format string }
+	if err := codec.NewDecoder(bufio.NewReader(f), &mh).Decode(&header); err != nil {
+		return false, nil
+	}
+	if header.Format != sketchFormat {
+		return false, fmt.Errorf("%s is neither FASTA nor a sketch file written by sketch", path)
+	}
+	return true, nil
+}
+
+// decodeSketches decodes a sketch file, failing on anything else.
+func decodeSketches(binary []byte, path string) (Sketches, error) {
+	var sketches Sketches
+	if err := codec.NewDecoderBytes(binary, &mh).Decode(&sketches); err != nil || sketches.Format != sketchFormat {
+		return Sketches{}, fmt.Errorf("%s is neither FASTA nor a sketch file written by sketch", path)
+	}
+	return sketches, nil
+}
+
+// loadSketches reads a sketch file to search a database, checking that it was
+// sketched with the k-mer length, hash function, scheme and mask of the
+// database. Sketches larger than the database are truncated, as the i-th
+// value of every k-mins sketch comes from the i-th hash seed and bottom-k
+// sketches are in ascending order.
+func loadSketches(fsys fileSystem, path string, plasmids Plasmids) (Sketches, error) {
+	k, sketchSize := plasmids.Kmer, plasmids.SketchSize
+	binary, err := readFile(fsys, path)
+	if err != nil {
+		return Sketches{}, err
+	}
+	sketches, err := decodeSketches(binary, path)
+	if err != nil {
+		return Sketches{}, err
+	}
+	if sketches.Kmer != k || sketches.SketchSize < sketchSize {
+		return Sketches{}, fmt.Errorf("%s was sketched with k=%d and sketch size %d, the database needs k=%d and a sketch size of at least %d", path, sketches.Kmer, sketches.SketchSize, k, sketchSize)
+	}
+	if sketches.Hash != plasmids.Hash {
+		return Sketches{}, fmt.Errorf("%s was sketched with %s, the database with %s", path, hashName(sketches.Hash), hashName(plasmids.Hash))
+	}
+	if sketches.Scheme != plasmids.Scheme {
+		return Sketches{}, fmt.Errorf("%s holds %s sketches, the database %s sketches", path, schemeName(sketches.Scheme), schemeName(plasmids.Scheme))
+	}
+	if sketches.Mask != plasmids.maskID() {
+		return Sketches{}, fmt.Errorf("%s was not sketched with the mask of the database (sketch with --db)", path)
+	}
+	for i, contig := range sketches.Contig {
+		if uint64(len(contig.MinHashValue)) > sketchSize {
+			sketches.Contig[i].MinHashValue = contig.MinHashValue[:sketchSize]
+		}
+		if uint64(len(contig.Abundances)) > sketchSize {
+			sketches.Contig[i].Abundances = contig.Abundances[:sketchSize]
+		}
+	}
+	sketches.SketchSize = sketchSize
+	return sketches, nil
+}
+
+// plasmids turns the contigs into records named by contig, so that dist and
+// tree can use a sketch file as a database.
+func (s Sketches) plasmids() Plasmids {
+	plasmids := Plasmids{SketchSize: s.SketchSize, Kmer: s.Kmer, Hash: s.Hash, Scheme: s.Scheme, Abundance: s.Abundance, sketchMask: s.Mask}
+	for _, contig := range s.Contig {
+		plasmids.Plasmid = append(plasmids.Plasmid, PlasmidRecord{AccID: contig.Name, PlasmidMinHashValue: contig.MinHashValue, Abundances: contig.Abundances})
+	}
+	return plasmids
+}
+
+// readSketches calls fn for every contig of sketches from as many goroutines
+// as CPUs, like readSequences does for FASTA.
+func readSketches(ctx context.Context, sketches Sketches, fn func(i int, contig ContigSketch) error) error {
+	var (
+		wg       sync.WaitGroup
+		firstErr error
+		next     int
+	)
+	mutex := new(sync.Mutex)
+
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = ctx.Err()
+				}
+				if firstErr != nil || next >= len(sketches.Contig) {
+					mutex.Unlock()
+					return
+				}
+				i := next
+				next++
+				mutex.Unlock()
+
+				if err := fn(i, sketches.Contig[i]); err != nil {
+					mutex.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mutex.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestIdentifySketchFile(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+
+	opts := &sketchOptions{In: testQueries, Out: "queries.phs", DB: testDB, FS: fsys}
+	if err := runSketch(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+
+	fromFasta := defaultIdentifyOptions(fsys)
+	fromFasta.Coverage = true
+	if err := runIdentify(context.Background(), fromFasta, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify FASTA: %v", err)
+	}
+	fromSketch := defaultIdentifyOptions(fsys)
+	fromSketch.In = "queries.phs"
+	fromSketch.OutDir = "sketch"
+	fromSketch.Coverage = true
+	if err := runIdentify(context.Background(), fromSketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify sketch file: %v", err)
+	}
+
+	if got, want := fsys.read(t, "sketch/pHash.tsv"), fsys.read(t, "out/pHash.tsv"); got != want {
+		t.Errorf("results from the sketch file differ from FASTA:\n%s\nwant:\n%s", got, want)
+	}
+	if _, err := fsys.Stat("sketch/pHash_plasmids.fna"); err == nil {
+		t.Error("identify wrote plasmid sequences from a sketch file")
+	}
+}
+
+func TestSketchFileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(opts *identifyOptions, fsys *memFS)
+		want   string
+	}{
+		{
+			name:   "other k-mer length",
+			modify: func(opts *identifyOptions, fsys *memFS) {},
+			want:   "queries.phs was sketched with k=12 and sketch size 128",
+		},
+		{
+			name:   "window",
+			modify: func(opts *identifyOptions, fsys *memFS) { opts.Window = 2000 },
+			want:   "need a FASTA input",
+		},
+		{
+			name: "neither FASTA nor sketches",
+			modify: func(opts *identifyOptions, fsys *memFS) {
+				fsys.write("queries.phs", fsys.read(t, testDB))
+			},
+			want: "queries.phs is neither FASTA nor a sketch file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newSyntheticFS()
+			makeSyntheticDB(t, fsys)
+			sketch := &sketchOptions{In: testQueries, Out: "queries.phs", Kmer: 12, Sketch: 128, FS: fsys}
+			if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+				t.Fatalf("sketch: %v", err)
+			}
+
+			opts := defaultIdentifyOptions(fsys)
+			opts.In = "queries.phs"
+			tt.modify(opts, fsys)
+			err := runIdentify(context.Background(), opts, ioutil.Discard, ioutil.Discard)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got error %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSketchFile(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	sketch := &sketchOptions{In: testQueries, Out: "queries.phs", Kmer: 16, Sketch: 128, FS: fsys}
+	if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+	fsys.write("blank.fna", "\n>a\nACGT\n")
+	fsys.write("bom.fna", "\xef\xbb\xbf>a\nACGT\n")
+	fsys.write("comment.fna", ";assembled with SPAdes\n>a\nACGT\n")
+	fsys.write("empty.fna", "")
+
+	tests := []struct {
+		path   string
+		sketch bool
+		err    string
+	}{
+		{path: testQueries},
+		{path: "blank.fna"},
+		{path: "bom.fna"},
+		{path: "comment.fna"},
+		{path: "empty.fna"},
+		{path: "queries.phs", sketch: true},
+		{path: testDB, err: testDB + " is neither FASTA nor a sketch file written by sketch"},
+	}
+	for _, tt := range tests {
+		sketch, err := isSketchFile(fsys, tt.path)
+		if sketch != tt.sketch || (err == nil) != (tt.err == "") || (err != nil && err.Error() != tt.err) {
+			t.Errorf("isSketchFile(%s) = %v, %v, want %v, %q", tt.path, sketch, err, tt.sketch, tt.err)
+		}
+	}
+}
+
+func TestDistSketchFile(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	sketch := &sketchOptions{In: testQueries, Out: "queries.phs", Kmer: 16, Sketch: 256, FS: fsys}
+	if err := runSketch(context.Background(), sketch, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("sketch: %v", err)
+	}
+
+	opts := &distOptions{DB: "queries.phs", Format: "long", Metric: "jaccard", Out: "dist.tsv", FS: fsys}
+	if err := runDist(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("dist: %v", err)
+	}
+	want := `reference1	reference2	similarity	distance
+exact	fragment	0.000000	1.000000
+exact	mutated	0.000000	1.000000
+exact	chimera	0.000000	1.000000
+exact	unrelated	0.000000	1.000000
+fragment	mutated	0.000000	1.000000
+fragment	chimera	0.000000	1.000000
+fragment	unrelated	0.000000	1.000000
+mutated	chimera	0.000000	1.000000
+mutated	unrelated	0.000000	1.000000
+chimera	unrelated	0.000000	1.000000
+`
+	if got := fsys.read(t, "dist.tsv"); got != want {
+		t.Errorf("distances among contigs:\n%s\nwant:\n%s", got, want)
+	}
+
+	opts = &distOptions{DB: "queries.phs", DB2: testDB, Format: "long", Metric: "jaccard", Out: "dist2.tsv", FS: fsys}
+	err := runDist(context.Background(), opts, ioutil.Discard, ioutil.Discard)
+	if err == nil || err.Error() != "databases differ in k-mer length or sketch size (k=16, sketch=256 and k=16, sketch=128)" {
+		t.Errorf("got error %v for sketch sizes 256 and 128", err)
+	}
+}
@@ -0,0 +1,15 @@
+pHash {{ .Run.Version }} k={{ .Run.Kmer }} sketch={{ .Run.SketchSize }} threshold={{ .Run.Threshold }} baseline={{ .Run.BaselineCoverage }}
+database {{ .Database.Path }} {{ .Database.Size }} {{ .Database.Modified }} {{ .Database.SHA256 }}
+input {{ .Input }} contigs={{ .Contigs }} generated={{ .Generated }}
+{{ range .Rows -}}
+row {{ .AccID }} {{ .Seq }} {{ .Length }} {{ .Phylum }} {{ printf "%.4f" .Jaccard }}{{ with .CopyNumber }} x{{ printf "%.2f" . }}{{ end }}{{ range .Hits }} {{ .AccID }}={{ .URL }}{{ end }}
+{{ end -}}
+{{ range .Phyla -}}
+phylum {{ .Phylum }} {{ .Contigs }} {{ .Bases }} {{ printf "%.1f" .Percent }}
+{{ end -}}
+{{ range .Histogram }}{{ if .Count -}}
+bin {{ printf "%.2f" .Lower }}-{{ printf "%.2f" .Upper }} {{ .Count }} {{ .Pass }}
+{{ end }}{{ end -}}
+{{ range .Candidates -}}
+candidate {{ .AccID }} {{ .Phylum }} {{ .Contigs }} {{ .Bases }} {{ printf "%.2f" .Coverage }} {{ printf "%.2f" .CopyNumber }}
+{{ end -}}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type treeOptions struct {
+	DB         string
+	DataDir    string
+	Accessions []string
+	All        bool
+	Result     string
+	Contigs    string
+	Method     string
+	Metric     string
+	Out        string
+	Force      bool
+	FS         fileSystem
+}
+
+var treeMethods = []string{"nj", "upgma"}
+
+// maxDefaultTaxa limits trees of all plasmids without --all; the distances
+// among n taxa take 8n² bytes, 1.2 GB for the RefSeq plasmids.
+const maxDefaultTaxa = 2000
+
+// treeNode is a node of a tree; Length is the branch to its parent.
+type treeNode struct {
+	Name     string
+	Length   float64
+	Children []*treeNode
+}
+
+func init() {
+	RootCmd.AddCommand(newTreeCmd())
+}
+
+func newTreeCmd() *cobra.Command {
+	opts := &treeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Tree of plasmids",
+		Long:  "Neighbor-joining or UPGMA tree of plasmids and contigs from their sketches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTree(cmd.Context(), opts, cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.DB, "db", "d", "", "Database file, or NAME[@VERSION] installed by init")
+	cmd.Flags().StringVar(&opts.DataDir, "data-dir", defaultDataDir(), "Directory of installed databases")
+	cmd.Flags().StringSliceVarP(&opts.Accessions, "accessions", "a", nil, fmt.Sprintf("Database plasmids to include (default all up to %d, unless --result or --contigs is given)", maxDefaultTaxa))
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Include all plasmids of the database, however many")
+	cmd.Flags().StringVarP(&opts.Result, "result", "r", "", "Include the best hits of contigs identified in this result of identify")
+	cmd.Flags().StringVarP(&opts.Contigs, "contigs", "c", "", "Include the contigs of this FASTA file, such as pHash_plasmids.fna")
+	cmd.Flags().StringVar(&opts.Method, "method", "nj", "Tree building method (nj or upgma)")
+	cmd.Flags().StringVar(&opts.Metric, "metric", "mash", "Distance (jaccard: 1 - similarity, or mash)")
+	cmd.Flags().StringVarP(&opts.Out, "out", "o", "", "Newick file (default standard output)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite an existing output file")
+
+	return cmd
+}
+
+func runTree(ctx context.Context, opts *treeOptions, stdout io.Writer, stderr io.Writer) error {
+	if opts.DB == "" {
+		return errors.New("--db is required")
+	}
+	if !containsString(treeMethods, opts.Method) {
+		return fmt.Errorf("--method must be one of %s", strings.Join(treeMethods, ", "))
+	}
+	if !containsString(distMetrics, opts.Metric) {
+		return fmt.Errorf("--metric must be one of %s", strings.Join(distMetrics, ", "))
+	}
+	if opts.All && (len(opts.Accessions) > 0 || opts.Result != "") {
+		return errors.New("--all cannot be used with --accessions or --result")
+	}
+	fsys := orOSFS(opts.FS)
+	if opts.Out != "" {
+		if err := checkOutputs(fsys, []string{opts.Out}, opts.Force); err != nil {
+			return err
+		}
+	}
+
+	plasmids, _, err := loadDatabase(fsys, opts.DB, opts.DataDir)
+	if err != nil {
+		return err
+	}
+
+	accessions := append([]string(nil), opts.Accessions...)
+	if opts.Result != "" {
+		f, err := fsys.Open(opts.Result)
+		if err != nil {
+			return err
+		}
+		records, err := readResults(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", opts.Result, err)
+		}
+		for _, record := range records {
+			if record.PassThreshold && record.Class != classChromosome && record.HitAccID != "" && !containsString(accessions, record.HitAccID) {
+				accessions = append(accessions, record.HitAccID)
+			}
+		}
+	}
+
+	var taxa []PlasmidRecord
+	if all := len(accessions) == 0 && opts.Result == "" && opts.Contigs == ""; all || opts.All {
+		if all && !opts.All && len(plasmids.Plasmid) > maxDefaultTaxa {
+			return fmt.Errorf("%s has %d plasmids, choose some with --accessions, --result or --contigs, or give --all for a tree of all of them", opts.DB, len(plasmids.Plasmid))
+		}
+		taxa = append(taxa, plasmids.Plasmid...)
+	} else {
+		byAccession := map[string]PlasmidRecord{}
+		for _, record := range plasmids.Plasmid {
+			byAccession[record.AccID] = record
+		}
+		for _, acc := range accessions {
+			record, ok := byAccession[acc]
+			if !ok {
+				return fmt.Errorf("%s is not in %s", acc, opts.DB)
+			}
+			taxa = append(taxa, record)
+		}
+	}
+
+	sk, err := plasmids.sketcher()
+	if err != nil {
+		return err
+	}
+	if opts.Contigs != "" {
+		contigs, err := sk.sketchFasta(ctx, fsys, opts.Contigs, 64, 0)
+		if err != nil {
+			return err
+		}
+		for _, contig := range contigs {
+			taxa = append(taxa, PlasmidRecord{AccID: contig.Name, PlasmidMinHashValue: contig.MinHashValue})
+		}
+	}
+	if len(taxa) < 2 {
+		return fmt.Errorf("a tree needs at least 2 plasmids or contigs, got %d", len(taxa))
+	}
+
+	names := make([]string, len(taxa))
+	distances := make([][]float64, len(taxa))
+	for i := range taxa {
+		names[i] = taxa[i].AccID
+		distances[i] = make([]float64, len(taxa))
+	}
+	err = computeRows(ctx, len(taxa), func(i int) []float32 {
+		row := make([]float32, len(taxa))
+		for j := range taxa {
+			row[j] = sketchSimilarity(sk.scheme, taxa[i], taxa[j], plasmids.SketchSize)
+		}
+		return row
+	}, func(i int, row []float32) error {
+		for j, similarity := range row {
+			distances[i][j] = sketchDistance(opts.Metric, similarity, plasmids.Kmer)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var tree *treeNode
+	if opts.Method == "upgma" {
+		tree = upgma(names, distances)
+	} else {
+		tree = neighborJoining(names, distances)
+	}
+
+	if opts.Out != "" {
+		f, err := createOutput(fsys, opts.Out, opts.Force)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := fmt.Fprintln(f, tree.newick()); err != nil {
+			return err
+		}
+		return f.Close()
+	}
+	_, err = fmt.Fprintln(stdout, tree.newick())
+	return err
+}
+
+// distanceTable holds the distances among the nodes left to join, listed in
+// active. A joined node takes the place of one of its children, so the table
+// stays n×n.
+type distanceTable struct {
+	nodes  []*treeNode
+	d      [][]float64
+	active []int
+}
+
+// newDistanceTable builds a table on distances, which the joins overwrite.
+func newDistanceTable(names []string, distances [][]float64) *distanceTable {
+	t := &distanceTable{nodes: make([]*treeNode, len(names)), d: distances}
+	for i, name := range names {
+		t.nodes[i] = &treeNode{Name: name}
+		t.active = append(t.active, i)
+	}
+	return t
+}
+
+// join replaces the active nodes a and b with a new node with the given
+// distance to every other active node, and returns the new node, which is
+// stored in place of a.
+func (t *distanceTable) join(a int, b int, distance func(k int) float64) int {
+	var active []int
+	var joined []float64
+	for _, k := range t.active {
+		if k != a && k != b {
+			active = append(active, k)
+			joined = append(joined, distance(k))
+		}
+	}
+	for x, k := range active {
+		t.d[a][k] = joined[x]
+		t.d[k][a] = joined[x]
+	}
+	t.d[a][a] = 0
+
+	t.nodes[a] = &treeNode{Children: []*treeNode{t.nodes[a], t.nodes[b]}}
+	t.active = append(active, a)
+	return a
+}
+
+// neighborJoining builds an unrooted tree with the method of Saitou and Nei,
+// written with a trifurcation at the top. Negative branch lengths are set to
+// 0 and ties are broken by input order.
+func neighborJoining(names []string, distances [][]float64) *treeNode {
+	t := newDistanceTable(names, distances)
+	d := t.d
+
+	for len(t.active) > 3 {
+		n := len(t.active)
+		r := map[int]float64{}
+		for _, i := range t.active {
+			for _, j := range t.active {
+				r[i] += d[i][j]
+			}
+		}
+
+		a, b := t.active[0], t.active[1]
+		best := math.Inf(1)
+		for x, i := range t.active {
+			for _, j := range t.active[x+1:] {
+				if q := float64(n-2)*d[i][j] - r[i] - r[j]; q < best {
+					a, b, best = i, j, q
+				}
+			}
+		}
+
+		la := d[a][b]/2 + (r[a]-r[b])/float64(2*(n-2))
+		t.nodes[a].Length = math.Max(0, la)
+		t.nodes[b].Length = math.Max(0, d[a][b]-la)
+		t.join(a, b, func(k int) float64 { return (d[a][k] + d[b][k] - d[a][b]) / 2 })
+	}
+
+	var children []*treeNode
+	if len(t.active) == 2 {
+		a, b := t.active[0], t.active[1]
+		t.nodes[a].Length = d[a][b] / 2
+		t.nodes[b].Length = d[a][b] / 2
+		children = []*treeNode{t.nodes[a], t.nodes[b]}
+	} else {
+		for x, i := range t.active {
+			j, k := t.active[(x+1)%3], t.active[(x+2)%3]
+			t.nodes[i].Length = math.Max(0, (d[i][j]+d[i][k]-d[j][k])/2)
+			children = append(children, t.nodes[i])
+		}
+	}
+	return &treeNode{Children: children}
+}
+
+// upgma builds a rooted ultrametric tree by average linkage.
+func upgma(names []string, distances [][]float64) *treeNode {
+	t := newDistanceTable(names, distances)
+	d := t.d
+	sizes := make([]int, len(t.nodes))
+	heights := make([]float64, len(t.nodes))
+	for i := range sizes {
+		sizes[i] = 1
+	}
+
+	for len(t.active) > 1 {
+		a, b := t.active[0], t.active[1]
+		for x, i := range t.active {
+			for _, j := range t.active[x+1:] {
+				if d[i][j] < d[a][b] {
+					a, b = i, j
+				}
+			}
+		}
+
+		height := d[a][b] / 2
+		t.nodes[a].Length = math.Max(0, height-heights[a])
+		t.nodes[b].Length = math.Max(0, height-heights[b])
+		joined := t.join(a, b, func(k int) float64 {
+			return (float64(sizes[a])*d[a][k] + float64(sizes[b])*d[b][k]) / float64(sizes[a]+sizes[b])
+		})
+		sizes[joined] = sizes[a] + sizes[b]
+		heights[joined] = height
+	}
+
+	return t.nodes[t.active[0]]
+}
+
+// newick writes the tree in Newick format, quoting names with characters
+// that have a meaning in it.
+func (t *treeNode) newick() string {
+	var b strings.Builder
+	t.writeNewick(&b)
+	b.WriteString(";")
+	return b.String()
+}
+
+func (t *treeNode) writeNewick(b *strings.Builder) {
+	if len(t.Children) > 0 {
+		b.WriteString("(")
+		for i, child := range t.Children {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			child.writeNewick(b)
+			b.WriteString(":")
+			b.WriteString(strconv.FormatFloat(child.Length, 'f', 6, 64))
+		}
+		b.WriteString(")")
+	}
+	if strings.ContainsAny(t.Name, "()[]':;, \t") {
+		b.WriteString("'" + strings.Replace(t.Name, "'", "''", -1) + "'")
+	} else {
+		b.WriteString(t.Name)
+	}
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func TestNeighborJoining(t *testing.T) {
+	// Additive distances of the tree ((A:1,B:2):1,(C:1,D:3)), which NJ
+	// recovers exactly.
+	names := []string{"A", "B", "C", "D"}
+	distances := [][]float64{
+		{0, 3, 3, 5},
+		{3, 0, 4, 6},
+		{3, 4, 0, 4},
+		{5, 6, 4, 0},
+	}
+	want := "(C:1.000000,D:3.000000,(A:1.000000,B:2.000000):1.000000);"
+	if got := neighborJoining(names, distances).newick(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNeighborJoiningFive(t *testing.T) {
+	// Additive distances of ((A:1,B:2):1,C:2,(D:1,E:3):1), which takes two
+	// joins of a node made by an earlier one.
+	names := []string{"A", "B", "C", "D", "E"}
+	distances := [][]float64{
+		{0, 3, 4, 4, 6},
+		{3, 0, 5, 5, 7},
+		{4, 5, 0, 4, 6},
+		{4, 5, 4, 0, 4},
+		{6, 7, 6, 4, 0},
+	}
+	want := "(D:1.000000,E:3.000000,(C:2.000000,(A:1.000000,B:2.000000):1.000000):1.000000);"
+	if got := neighborJoining(names, distances).newick(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestUPGMA(t *testing.T) {
+	names := []string{"A", "B", "C"}
+	distances := [][]float64{
+		{0, 2, 6},
+		{2, 0, 6},
+		{6, 6, 0},
+	}
+	want := "(C:3.000000,(A:1.000000,B:1.000000):2.000000);"
+	if got := upgma(names, distances).newick(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestUPGMAClusters(t *testing.T) {
+	names := []string{"A", "C", "B", "D"}
+	distances := [][]float64{
+		{0, 10, 2, 10},
+		{10, 0, 10, 4},
+		{2, 10, 0, 10},
+		{10, 4, 10, 0},
+	}
+	want := "((A:1.000000,B:1.000000):4.000000,(C:2.000000,D:2.000000):3.000000);"
+	if got := upgma(names, distances).newick(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNewickQuoting(t *testing.T) {
+	tree := &treeNode{Children: []*treeNode{{Name: "NODE_1 cov=2", Length: 0.5}, {Name: "it's", Length: 0.25}}}
+	want := "('NODE_1 cov=2':0.500000,'it''s':0.250000);"
+	if got := tree.newick(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestTreeFromIdentify(t *testing.T) {
+	fsys := newSyntheticFS()
+	makeSyntheticDB(t, fsys)
+	if err := runIdentify(context.Background(), defaultIdentifyOptions(fsys), ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("identify: %v", err)
+	}
+
+	opts := &treeOptions{DB: testDB, Result: "out/pHash.tsv", Contigs: "out/pHash_plasmids.fna", Method: "nj", Metric: "mash", Out: "tree.nwk", FS: fsys}
+	if err := runTree(context.Background(), opts, ioutil.Discard, ioutil.Discard); err != nil {
+		t.Fatalf("tree: %v", err)
+	}
+
+	// Every identified contig is the sister of its hit, the exact copy of
+	// NZ_SYN0001.1 with no distance; unrelated was not identified.
+	want := "((NZ_SYN0003.1:0.004633,mutated:0.004633):0.495367,(NZ_SYN0004.1:0.033928,chimera:0.033928):0.466072,((NZ_SYN0001.1:0.000000,exact:0.000000):0.500000,(NZ_SYN0002.1:0.004271,fragment:0.004271):0.495729):0.000000);\n"
+	if got := fsys.read(t, "tree.nwk"); got != want {
+		t.Errorf("tree %s, want %s", got, want)
+	}
+}
+
+func TestTreeAllPlasmids(t *testing.T) {
+	plasmids := &Plasmids{SketchSize: 1, Kmer: 16}
+	for i := 0; i <= maxDefaultTaxa; i++ {
+		plasmids.Plasmid = append(plasmids.Plasmid, PlasmidRecord{AccID: fmt.Sprintf("P%d", i), PlasmidMinHashValue: []uint64{uint64(i)}})
+	}
+	b, err := messagePackEncoding(plasmids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := newMemFS()
+	fsys.write("db.phash", string(b))
+
+	tests := []struct {
+		opts treeOptions
+		want string
+	}{
+		{treeOptions{}, fmt.Sprintf("db.phash has %d plasmids, choose some with --accessions, --result or --contigs, or give --all for a tree of all of them", maxDefaultTaxa+1)},
+		{treeOptions{All: true, Accessions: []string{"P1"}}, "--all cannot be used with --accessions or --result"},
+	}
+	for _, tt := range tests {
+		opts := tt.opts
+		opts.DB, opts.Method, opts.Metric, opts.FS = "db.phash", "nj", "mash", fsys
+		err := runTree(context.Background(), &opts, ioutil.Discard, ioutil.Discard)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("got error %v, want %q", err, tt.want)
+		}
+	}
+
+	var out bytes.Buffer
+	opts := &treeOptions{DB: "db.phash", Accessions: []string{"P1", "P2"}, Method: "nj", Metric: "jaccard", FS: fsys}
+	if err := runTree(context.Background(), opts, &out, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if want := "(P1:0.500000,P2:0.500000);\n"; out.String() != want {
+		t.Errorf("got %s, want %s", out.String(), want)
+	}
+}
@@ -1,8 +1,16 @@
 package cmd
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
 
+	"github.com/biogo/biogo/alphabet"
+	"github.com/biogo/biogo/io/seqio/fasta"
+	"github.com/biogo/biogo/seq"
 	"github.com/ugorji/go/codec"
 )
 
@@ -11,46 +19,143 @@ type (
 		AccID               string
 		Phylum              string
 		PlasmidMinHashValue []uint64
+
+		// Members are the accessions db derep merged into this record.
+		Members []string `codec:",omitempty"`
+
+		// Abundances holds the number of times the k-mer of each value of
+		// PlasmidMinHashValue occurs, in databases with Abundance.
+		Abundances []uint32 `codec:",omitempty"`
 	}
 
 	Plasmids struct {
 		SketchSize uint64
 		Kmer       int
 		Plasmid    []PlasmidRecord
-	}
 
-	Options struct {
-		optIn          string
-		optBuildOut    string
-		optIdentifyOut string
-		optDB          string
-		optMetadata    string
-		optKmer        int
-		optSketch      int
-		optThreshold   int
+		// Chromosome holds sketches of chromosome chunks, named
+		// <accession>:<start>-<end>, used to tell chromosomal contigs from
+		// plasmids. Databases without them decode as before.
+		Chromosome []PlasmidRecord `codec:",omitempty"`
+
+		// Hash names the Hasher of the sketches, empty for xxhash64, and
+		// Scheme their sketchScheme, empty for kmins.
+		Hash   string `codec:",omitempty"`
+		Scheme string `codec:",omitempty"`
+
+		// Abundance tells that the records keep the counts of the k-mers
+		// of their bottom-k sketches, as sourmash does with
+		// track_abundance.
+		Abundance bool `codec:",omitempty"`
+
+		// Mask holds the keys of the k-mers left out of the sketches, in
+		// order, for identify to leave out the same k-mers.
+		Mask []uint64 `codec:",omitempty"`
+
+		// sketchMask is the mask digest of a sketch file read as a
+		// database, which holds no mask keys.
+		sketchMask string
 	}
 )
 
 var (
 	mh codec.MsgpackHandle
+
+	ambiguousDnaComplement = strings.NewReplacer(
+		"A", "T",
+		"C", "G",
+		"G", "C",
+		"T", "A",
+		"M", "K",
+		"R", "Y",
+		"Y", "R",
+		"K", "M",
+		"V", "B",
+		"H", "D",
+		"D", "H",
+		"B", "V")
 )
 
-func messagePackEncoding(plasmids *Plasmids) []byte {
+// maskID identifies the mask of a database or sketch file, empty without one.
+func (p Plasmids) maskID() string {
+	if p.sketchMask != "" {
+		return p.sketchMask
+	}
+	return maskDigest(p.Mask)
+}
+
+func messagePackEncoding(plasmids *Plasmids) ([]byte, error) {
 	buf := make([]byte, 0, 64)
 	err := codec.NewEncoderBytes(&buf, &mh).Encode(*plasmids)
 	if err != nil {
-		log.Printf("error encoding %v to MessagePack: %v", *plasmids, err)
+		return nil, fmt.Errorf("error encoding database to MessagePack: %v", err)
 	}
-	return buf
+	return buf, nil
 }
 
-func messagePackDecoding(buf *[]byte) Plasmids {
+func messagePackDecoding(buf *[]byte) (Plasmids, error) {
 	var plasmids Plasmids
 	err := codec.NewDecoderBytes(*buf, &mh).Decode(&plasmids)
 	if err != nil {
-		log.Printf("error decoding %v to MessagePack: %v", buf, err)
+		return plasmids, fmt.Errorf("error decoding database from MessagePack: %v", err)
+	}
+	return plasmids, nil
+}
+
+// readSequences calls fn for every sequence of in from the given number of
+// goroutines, along with the position of the sequence in the input. It stops
+// at the first error returned by the reader or fn, or when ctx is done.
+func readSequences(ctx context.Context, in *fasta.Reader, workers int, fn func(i int, s seq.Sequence) error) error {
+	var (
+		wg       sync.WaitGroup
+		firstErr error
+		next     int
+	)
+	mutex := new(sync.Mutex)
+
+	fail := func(err error) {
+		mutex.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mutex.Unlock()
 	}
-	return plasmids
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mutex.Lock()
+				if firstErr == nil {
+					firstErr = ctx.Err()
+				}
+				if firstErr != nil {
+					mutex.Unlock()
+					return
+				}
+				s, err := in.Read()
+				i := next
+				next++
+				mutex.Unlock()
+
+				if err != nil {
+					if err != io.EOF {
+						fail(err)
+					}
+					return
+				}
+
+				if err := fn(i, s); err != nil {
+					fail(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
 }
 
 func rev(seq *string) string {
@@ -61,3 +166,107 @@ func rev(seq *string) string {
 
 	return string(runes)
 }
+
+// canonicalKmers returns the distinct k-mers of read without N and outside
+// the mask, each replaced by whichever of itself and its reverse complement
+// the hasher picks.
+func canonicalKmers(read alphabet.Slice, k int, hasher Hasher, mask kmerMask) map[string]struct{} {
+	kmerNum := read.Len() - (k - 1)
+	if kmerNum < 0 {
+		kmerNum = 0
+	}
+	kmerMap := make(map[string]struct{}, kmerNum)
+
+	for i := 0; i < kmerNum; i++ {
+		if canonicalKmer, ok := canonicalKmerAt(read, i, k, hasher); ok && !mask.contains(canonicalKmer) {
+			kmerMap[canonicalKmer] = struct{}{}
+		}
+	}
+
+	return kmerMap
+}
+
+// canonicalKmerAt returns the canonical k-mer starting at i, or false if it
+// contains N.
+func canonicalKmerAt(read alphabet.Slice, i int, k int, hasher Hasher) (string, bool) {
+	seq := read.Slice(i, i+k).(alphabet.Letters).String()
+	if strings.Index(seq, "N") != -1 {
+		return "", false
+	}
+	reverseComplement := ambiguousDnaComplement.Replace(rev(&seq))
+	return hasher.Canonical(seq, reverseComplement), true
+}
+
+func calcMinHash(read alphabet.Slice, k int, sketchSize uint64, hasher Hasher, mask kmerMask) ([]uint64, int) {
+	kmerMap := canonicalKmers(read, k, hasher, mask)
+
+	kmerList := make([][]byte, 0, len(kmerMap)+1)
+	for key := range kmerMap {
+		kmerList = append(kmerList, []byte(key))
+	}
+	// The xxhash64 sketches of pHash, including those of the released
+	// databases, have always taken the hash of an empty k-mer along with
+	// those of the k-mers of a sequence with any; it is kept so that they
+	// stay comparable. Other hash functions hash the k-mers alone.
+	if _, legacy := hasher.(xxhash64Hasher); legacy && len(kmerMap) > 0 {
+		kmerList = append(kmerList, nil)
+	}
+
+	minHashValues := make([]uint64, sketchSize)
+
+	for i := uint64(0); i < sketchSize; i++ {
+		minValue := uint64((1 << 64) - 1)
+		for _, kmer := range kmerList {
+			xxhv := hasher.Hash(kmer, i)
+			if minValue > xxhv {
+				minValue = xxhv
+			}
+		}
+		minHashValues[i] = minValue
+	}
+
+	return minHashValues, len(kmerMap)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOutputs fails before any work is done when an output exists and force
+// is not set. createOutput checks again when the output is created.
+func checkOutputs(fsys fileSystem, paths []string, force bool) error {
+	if force {
+		return nil
+	}
+
+	var existing []string
+	for _, path := range paths {
+		if _, err := fsys.Stat(path); err == nil {
+			existing = append(existing, path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("output already exists: %s (use --force to overwrite)", strings.Join(existing, ", "))
+	}
+	return nil
+}
+
+// createOutput creates an output file. Without force, the file is created
+// exclusively, so that one written since checkOutputs is not overwritten.
+func createOutput(fsys fileSystem, path string, force bool) (io.WriteCloser, error) {
+	if force {
+		return fsys.Create(path)
+	}
+	f, err := fsys.CreateNew(path)
+	if os.IsExist(err) {
+		return nil, fmt.Errorf("output already exists: %s (use --force to overwrite)", path)
+	}
+	return f, err
+}
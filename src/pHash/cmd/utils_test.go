@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/biogo/biogo/alphabet"
+)
+
+func exactJaccard(a, b map[string]struct{}) float64 {
+	var shared int
+	for kmer := range a {
+		if _, ok := b[kmer]; ok {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+func letters(s string) alphabet.Slice {
+	return alphabet.Letters(alphabet.BytesToLetters([]byte(s)))
+}
+
+// TestSimilarityAccuracy checks the MinHash estimate against the exact
+// Jaccard index of the canonical k-mer sets over a range of mutation rates.
+// The estimate is a mean of sketchSize Bernoulli trials, so it should lie
+// within a few standard deviations of the exact value.
+func TestSimilarityAccuracy(t *testing.T) {
+	const (
+		k          = 16
+		sketchSize = 256
+	)
+	rng := rand.New(rand.NewSource(1))
+
+	for _, rate := range []float64{0, 0.001, 0.005, 0.01, 0.02, 0.05, 0.1} {
+		for trial := 0; trial < 3; trial++ {
+			original := randomSequence(rng, 3000)
+			mutated := mutate(rng, original, rate)
+
+			exact := exactJaccard(canonicalKmers(letters(original), k, defaultHasher, nil), canonicalKmers(letters(mutated), k, defaultHasher, nil))
+			a, _ := calcMinHash(letters(original), k, sketchSize, defaultHasher, nil)
+			b, _ := calcMinHash(letters(mutated), k, sketchSize, defaultHasher, nil)
+			estimate := float64(calcSimilarity(defaultScheme, a, b, sketchSize))
+
+			tolerance := 4*math.Sqrt(exact*(1-exact)/sketchSize) + 0.01
+			if math.Abs(estimate-exact) > tolerance {
+				t.Errorf("rate %.3f: estimate %.4f, exact Jaccard %.4f (tolerance %.4f)", rate, estimate, exact, tolerance)
+			}
+		}
+	}
+}
+
+func TestSimilarityStrandIndependent(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	s := randomSequence(rng, 2000)
+
+	forward, n := calcMinHash(letters(s), 16, 128, defaultHasher, nil)
+	reverse, m := calcMinHash(letters(reverseComplement(s)), 16, 128, defaultHasher, nil)
+	if n != m {
+		t.Errorf("%d k-mers on the forward strand, %d on the reverse", n, m)
+	}
+	if got := calcSimilarity(defaultScheme, forward, reverse, 128); got < 0.999 {
+		t.Errorf("similarity to the reverse complement is %f, want 1", got)
+	}
+}
+
+func TestSimilarityUnrelated(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	a, _ := calcMinHash(letters(randomSequence(rng, 3000)), 16, 256, defaultHasher, nil)
+	b, _ := calcMinHash(letters(randomSequence(rng, 3000)), 16, 256, defaultHasher, nil)
+
+	if got := calcSimilarity(defaultScheme, a, b, 256); got > 0.02 {
+		t.Errorf("similarity of unrelated sequences is %f, want about 0", got)
+	}
+}
+
+func TestCanonicalKmersSkipsN(t *testing.T) {
+	kmers := canonicalKmers(letters("ACGTACGTNACGTACGTA"), 8, defaultHasher, nil)
+	for kmer := range kmers {
+		for _, c := range kmer {
+			if c == 'N' {
+				t.Fatalf("k-mer %s contains N", kmer)
+			}
+		}
+	}
+	if len(kmers) == 0 {
+		t.Error("no k-mers outside the N")
+	}
+}
+
+func TestCreateOutput(t *testing.T) {
+	fsys := newMemFS()
+	f, err := createOutput(fsys, "out.tsv", false)
+	if err != nil {
+		t.Fatalf("createOutput: %v", err)
+	}
+	// A second writer racing the first must not overwrite its output.
+	if _, err := createOutput(fsys, "out.tsv", false); err == nil || err.Error() != "output already exists: out.tsv (use --force to overwrite)" {
+		t.Errorf("got error %v for an output created since the check", err)
+	}
+	f.Close()
+	if _, err := createOutput(fsys, "out.tsv", true); err != nil {
+		t.Errorf("createOutput with force: %v", err)
+	}
+}
+
+// TestCalcMinHashDistinctKmers checks that every sketch value is the minimum
+// over the distinct canonical k-mers, and for xxhash64 the empty k-mer, and
+// nothing else.
+func TestCalcMinHashDistinctKmers(t *testing.T) {
+	rng := rand.New(rand.NewSource(48))
+	for _, s := range []string{"ACGTACGTACGTACGTAC", randomSequence(rng, 40), "AAAAAAAAAAAAAAAAAAAAAAAA" + randomSequence(rng, 30)} {
+		for _, hasher := range hashers {
+			kmers := canonicalKmers(letters(s), 16, hasher, nil)
+			sketch, n := calcMinHash(letters(s), 16, 32, hasher, nil)
+			if n != len(kmers) {
+				t.Errorf("%s: %d k-mers, want %d", hasher.Name(), n, len(kmers))
+			}
+			if hasher.Name() == "xxhash64" {
+				kmers[""] = struct{}{}
+			}
+			for i, value := range sketch {
+				want := uint64(math.MaxUint64)
+				for kmer := range kmers {
+					if h := hasher.Hash([]byte(kmer), uint64(i)); h < want {
+						want = h
+					}
+				}
+				if value != want {
+					t.Errorf("%s: value %d of the sketch of %s is %d, want %d", hasher.Name(), i, s, value, want)
+					break
+				}
+			}
+		}
+	}
+}
+
+// TestCalcMinHashNoKmers checks that a sequence without k-mers has an empty
+// sketch, for xxhash64 too.
+func TestCalcMinHashNoKmers(t *testing.T) {
+	for _, hasher := range hashers {
+		sketch, n := calcMinHash(letters("ACGTNACGT"), 5, 4, hasher, nil)
+		want := []uint64{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+		if n != 0 || !reflect.DeepEqual(sketch, want) {
+			t.Errorf("%s: got %v with %d k-mers, want %v", hasher.Name(), sketch, n, want)
+		}
+	}
+}
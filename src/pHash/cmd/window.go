@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"sort"
+)
+
+type windowHit struct {
+	Start      int
+	End        int
+	AccID      string
+	Similarity float32
+}
+
+// slidingWindows returns the [start, end) ranges scanned along a contig.
+// The last window is aligned to the end of the contig so that its tail is
+// always covered; contigs shorter than the window yield a single range.
+func slidingWindows(length int, window int, step int) [][2]int {
+	if length <= window {
+		return [][2]int{{0, length}}
+	}
+
+	var windows [][2]int
+	for start := 0; ; start += step {
+		if start+window >= length {
+			windows = append(windows, [2]int{length - window, length})
+			break
+		}
+		windows = append(windows, [2]int{start, start + window})
+	}
+
+	return windows
+}
+
+// mergeWindowHits joins overlapping or adjacent windows matching the same
+// reference plasmid into a single range keeping the highest similarity.
+func mergeWindowHits(hits []windowHit) []windowHit {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].AccID != hits[j].AccID {
+			return hits[i].AccID < hits[j].AccID
+		}
+		return hits[i].Start < hits[j].Start
+	})
+
+	var merged []windowHit
+	for _, hit := range hits {
+		last := len(merged) - 1
+		if last >= 0 && merged[last].AccID == hit.AccID && hit.Start <= merged[last].End {
+			if hit.End > merged[last].End {
+				merged[last].End = hit.End
+			}
+			if hit.Similarity > merged[last].Similarity {
+				merged[last].Similarity = hit.Similarity
+			}
+			continue
+		}
+		merged = append(merged, hit)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Start < merged[j].Start
+	})
+
+	return merged
+}
+
+func bedScore(similarity float32) int {
+	score := int(similarity*1000 + 0.5)
+	if score < 0 {
+		return 0
+	}
+	if score > 1000 {
+		return 1000
+	}
+	return score
+}
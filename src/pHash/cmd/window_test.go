@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSlidingWindows(t *testing.T) {
+	tests := []struct {
+		length, window, step int
+		want                 [][2]int
+	}{
+		{length: 10, window: 4, step: 2, want: [][2]int{{0, 4}, {2, 6}, {4, 8}, {6, 10}}},
+		{length: 11, window: 4, step: 4, want: [][2]int{{0, 4}, {4, 8}, {7, 11}}},
+		{length: 3, window: 4, step: 2, want: [][2]int{{0, 3}}},
+	}
+
+	for _, tt := range tests {
+		if got := slidingWindows(tt.length, tt.window, tt.step); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("slidingWindows(%d, %d, %d) = %v, want %v", tt.length, tt.window, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestMergeWindowHits(t *testing.T) {
+	hits := []windowHit{
+		{Start: 0, End: 100, AccID: "A", Similarity: 0.2},
+		{Start: 50, End: 150, AccID: "A", Similarity: 0.4},
+		{Start: 50, End: 150, AccID: "B", Similarity: 0.3},
+		{Start: 150, End: 250, AccID: "A", Similarity: 0.1},
+		{Start: 400, End: 500, AccID: "A", Similarity: 0.5},
+	}
+	want := []windowHit{
+		{Start: 0, End: 250, AccID: "A", Similarity: 0.4},
+		{Start: 50, End: 150, AccID: "B", Similarity: 0.3},
+		{Start: 400, End: 500, AccID: "A", Similarity: 0.5},
+	}
+
+	if got := mergeWindowHits(hits); !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeWindowHits() = %v, want %v", got, want)
+	}
+}
+
+func TestBedScore(t *testing.T) {
+	for similarity, want := range map[float32]int{-0.1: 0, 0: 0, 0.5: 500, 1: 1000, 1.2: 1000} {
+		if got := bedScore(similarity); got != want {
+			t.Errorf("bedScore(%v) = %d, want %d", similarity, got, want)
+		}
+	}
+}